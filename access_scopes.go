@@ -1,5 +1,7 @@
 package goshopify
 
+import "strings"
+
 type AccessScopesService interface {
 	List(interface{}) ([]AccessScope, error)
 }
@@ -26,3 +28,76 @@ func (s *AccessScopesServiceOp) List(options interface{}) ([]AccessScope, error)
 	err := s.client.Get(path, resource, options)
 	return resource.AccessScopes, err
 }
+
+// ScopeDrift describes the scopes an app requires but that have not been
+// granted by a shop, see Client.CheckAccessScopes.
+type ScopeDrift struct {
+	// Missing lists the required scopes that have not been granted
+	Missing []string
+
+	// AuthorizeUrl is the url to redirect the merchant to in order to grant
+	// the missing scopes, empty when no scopes are missing
+	AuthorizeUrl string
+}
+
+// RequiredScopes parses a comma separated list of scopes, such as App.Scope,
+// and adds the read_ scopes implied by write_ scopes.
+func RequiredScopes(scope string) []string {
+	scopes := []string{}
+	seen := map[string]bool{}
+	add := func(s string) {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			scopes = append(scopes, s)
+		}
+	}
+
+	for _, s := range strings.Split(scope, ",") {
+		s = strings.TrimSpace(s)
+		add(s)
+		if strings.Contains(s, "write_") {
+			add(strings.Replace(s, "write_", "read_", 1))
+		}
+	}
+	return scopes
+}
+
+// MissingScopes returns the required scopes that are not covered by the
+// granted access scopes. A granted write_ scope covers the matching read_
+// scope.
+func MissingScopes(required []string, granted []AccessScope) []string {
+	have := map[string]bool{}
+	for _, g := range granted {
+		have[g.Handle] = true
+		if strings.Contains(g.Handle, "write_") {
+			have[strings.Replace(g.Handle, "write_", "read_", 1)] = true
+		}
+	}
+
+	missing := []string{}
+	for _, r := range required {
+		if !have[r] {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
+
+// CheckAccessScopes compares the scopes required by the client's app, see
+// App.Scope, with the scopes granted to the current access token. When scopes
+// are missing, the returned ScopeDrift includes an authorization url with the
+// given state to request them from the merchant.
+func (c *Client) CheckAccessScopes(state string) (*ScopeDrift, error) {
+	granted, err := c.AccessScopes.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &ScopeDrift{
+		Missing: MissingScopes(RequiredScopes(c.app.Scope), granted),
+	}
+	if len(drift.Missing) > 0 {
+		drift.AuthorizeUrl = c.app.AuthorizeUrl(c.baseURL.Host, state)
+	}
+	return drift, nil
+}
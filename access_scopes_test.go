@@ -35,3 +35,78 @@ func TestAccessScopesServiceOp_List(t *testing.T) {
 		t.Errorf("AccessScopes.List returned %+v, expected %+v", expected, expected)
 	}
 }
+
+func TestRequiredScopes(t *testing.T) {
+	cases := []struct {
+		scope    string
+		expected []string
+	}{
+		{"", []string{}},
+		{"read_products", []string{"read_products"}},
+		{"write_products, read_orders", []string{"write_products", "read_products", "read_orders"}},
+		{"write_products,read_products", []string{"write_products", "read_products"}},
+		{"unauthenticated_write_checkouts", []string{"unauthenticated_write_checkouts", "unauthenticated_read_checkouts"}},
+	}
+
+	for _, c := range cases {
+		actual := RequiredScopes(c.scope)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("RequiredScopes(%q) returned %v, expected %v", c.scope, actual, c.expected)
+		}
+	}
+}
+
+func TestMissingScopes(t *testing.T) {
+	granted := []AccessScope{{Handle: "write_products"}, {Handle: "read_orders"}}
+
+	cases := []struct {
+		required []string
+		expected []string
+	}{
+		{[]string{"read_products", "write_products"}, []string{}},
+		{[]string{"read_orders", "write_orders", "read_customers"}, []string{"write_orders", "read_customers"}},
+	}
+
+	for _, c := range cases {
+		actual := MissingScopes(c.required, granted)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("MissingScopes(%v) returned %v, expected %v", c.required, actual, c.expected)
+		}
+	}
+}
+
+func TestClientCheckAccessScopes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/oauth/access_scopes.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"access_scopes":[{"handle":"read_products"}]}`),
+	)
+
+	client.app.Scope = "read_products,write_orders"
+	drift, err := client.CheckAccessScopes("thenonce")
+	if err != nil {
+		t.Fatalf("Client.CheckAccessScopes returned an error: %v", err)
+	}
+
+	expected := &ScopeDrift{
+		Missing:      []string{"write_orders", "read_orders"},
+		AuthorizeUrl: "https://fooshop.myshopify.com/admin/oauth/authorize?client_id=apikey&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback&scope=read_products%2Cwrite_orders&state=thenonce",
+	}
+	if !reflect.DeepEqual(drift, expected) {
+		t.Errorf("Client.CheckAccessScopes returned %+v, expected %+v", drift, expected)
+	}
+
+	client.app.Scope = "read_products"
+	drift, err = client.CheckAccessScopes("thenonce")
+	if err != nil {
+		t.Fatalf("Client.CheckAccessScopes returned an error: %v", err)
+	}
+
+	expected = &ScopeDrift{Missing: []string{}}
+	if !reflect.DeepEqual(drift, expected) {
+		t.Errorf("Client.CheckAccessScopes returned %+v, expected %+v", drift, expected)
+	}
+}
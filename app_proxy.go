@@ -0,0 +1,99 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultAppProxyTimestampWindow is the maximum age, or clock difference,
+// allowed by AppProxyMiddleware for the timestamp of an app proxy request when
+// no window is given.
+const DefaultAppProxyTimestampWindow = 5 * time.Minute
+
+// appProxyNow returns the current time, overridden in tests.
+var appProxyNow = time.Now
+
+type appProxyContextKey struct{}
+
+// AppProxyContext represents the parameters Shopify adds to requests sent to
+// an app proxy.
+// See https://shopify.dev/docs/apps/online-store/app-proxies
+type AppProxyContext struct {
+	Shop               string
+	LoggedInCustomerID int64
+	PathPrefix         string
+	Timestamp          time.Time
+}
+
+// VerifyAppProxyRequest verifies the signature of an app proxy request, see
+// VerifySignature, and that its timestamp is within window of the current
+// time, and returns the parameters Shopify added to the request.
+func (app App) VerifyAppProxyRequest(r *http.Request, window time.Duration) (*AppProxyContext, error) {
+	if !app.VerifySignature(r.URL) {
+		return nil, errors.New("app proxy signature is invalid")
+	}
+
+	q := r.URL.Query()
+
+	shop := q.Get("shop")
+	if err := ValidateShopDomain(shop); err != nil {
+		return nil, err
+	}
+
+	ts, err := strconv.ParseInt(q.Get("timestamp"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("app proxy timestamp is invalid: %v", err)
+	}
+	timestamp := time.Unix(ts, 0)
+	if age := appProxyNow().Sub(timestamp); age > window || age < -window {
+		return nil, fmt.Errorf("app proxy timestamp %s is outside the allowed window", timestamp.UTC().Format(time.RFC3339))
+	}
+
+	var customerID int64
+	if id := q.Get("logged_in_customer_id"); id != "" {
+		customerID, err = strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("app proxy logged_in_customer_id is invalid: %v", err)
+		}
+	}
+
+	return &AppProxyContext{
+		Shop:               shop,
+		LoggedInCustomerID: customerID,
+		PathPrefix:         q.Get("path_prefix"),
+		Timestamp:          timestamp,
+	}, nil
+}
+
+// AppProxyMiddleware returns an http middleware that verifies app proxy
+// requests sent by Shopify. Requests with an invalid signature or a timestamp
+// more than window away from the current time are rejected with
+// 401 Unauthorized, otherwise the proxy parameters are stored in the request
+// context, see AppProxyFromContext. A window of zero uses
+// DefaultAppProxyTimestampWindow.
+func (app App) AppProxyMiddleware(next http.Handler, window time.Duration) http.Handler {
+	if window <= 0 {
+		window = DefaultAppProxyTimestampWindow
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxy, err := app.VerifyAppProxyRequest(r, window)
+		if err != nil {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), appProxyContextKey{}, proxy)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// AppProxyFromContext returns the app proxy parameters stored in the context
+// by AppProxyMiddleware.
+func AppProxyFromContext(ctx context.Context) (*AppProxyContext, bool) {
+	proxy, ok := ctx.Value(appProxyContextKey{}).(*AppProxyContext)
+	return proxy, ok
+}
@@ -0,0 +1,139 @@
+package goshopify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+const appProxyTestTimestamp = 1317327555
+
+// signAppProxyQuery adds the signature parameter to the app proxy query
+func signAppProxyQuery(secret string, q url.Values) string {
+	keys := []string{}
+	for k, v := range q {
+		keys = append(keys, fmt.Sprintf("%s=%s", k, strings.Join(v, ",")))
+	}
+	sort.Strings(keys)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.Join(keys, "")))
+	q.Set("signature", hex.EncodeToString(mac.Sum(nil)))
+	return q.Encode()
+}
+
+func setAppProxyNow(t time.Time) func() {
+	appProxyNow = func() time.Time { return t }
+	return func() { appProxyNow = time.Now }
+}
+
+func TestVerifyAppProxyRequest(t *testing.T) {
+	setup()
+	defer teardown()
+	defer setAppProxyNow(time.Unix(appProxyTestTimestamp+60, 0))()
+
+	q := url.Values{}
+	q.Set("shop", "shop-name.myshopify.com")
+	q.Set("logged_in_customer_id", "207119551")
+	q.Set("path_prefix", "/apps/awesome_reviews")
+	q.Set("timestamp", fmt.Sprint(appProxyTestTimestamp))
+	r := httptest.NewRequest("GET", "/proxied?"+signAppProxyQuery("hush", q), nil)
+
+	proxy, err := app.VerifyAppProxyRequest(r, time.Minute*5)
+	if err != nil {
+		t.Fatalf("App.VerifyAppProxyRequest returned error: %v", err)
+	}
+
+	expected := &AppProxyContext{
+		Shop:               "shop-name.myshopify.com",
+		LoggedInCustomerID: 207119551,
+		PathPrefix:         "/apps/awesome_reviews",
+		Timestamp:          time.Unix(appProxyTestTimestamp, 0),
+	}
+	if !reflect.DeepEqual(proxy, expected) {
+		t.Errorf("App.VerifyAppProxyRequest returned %+v, expected %+v", proxy, expected)
+	}
+}
+
+func TestVerifyAppProxyRequestInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// https://shopify.dev/tutorials/display-data-on-an-online-store-with-an-application-proxy-app-extension
+	queryString := "extra=1&extra=2&shop=shop-name.myshopify.com&path_prefix=%2Fapps%2Fawesome_reviews&timestamp=1317327555&signature=a9718877bea71c2484f91608a7eaea1532bdf71f5c56825065fa4ccabe549ef3"
+
+	badShop := url.Values{}
+	badShop.Set("shop", "evil.com")
+	badShop.Set("timestamp", fmt.Sprint(appProxyTestTimestamp))
+
+	cases := []struct {
+		name  string
+		query string
+		now   time.Time
+	}{
+		{"tampered", queryString + "&logged_in_customer_id=1", time.Unix(appProxyTestTimestamp, 0)},
+		{"expired", queryString, time.Unix(appProxyTestTimestamp, 0).Add(10 * time.Minute)},
+		{"future", queryString, time.Unix(appProxyTestTimestamp, 0).Add(-10 * time.Minute)},
+		{"invalid shop", signAppProxyQuery("hush", badShop), time.Unix(appProxyTestTimestamp, 0)},
+	}
+
+	for _, c := range cases {
+		reset := setAppProxyNow(c.now)
+		r := httptest.NewRequest("GET", "/proxied?"+c.query, nil)
+		_, err := app.VerifyAppProxyRequest(r, 5*time.Minute)
+		reset()
+		if err == nil {
+			t.Errorf("App.VerifyAppProxyRequest with %s request returned no error", c.name)
+		}
+	}
+}
+
+func TestAppProxyMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	queryString := "extra=1&extra=2&shop=shop-name.myshopify.com&path_prefix=%2Fapps%2Fawesome_reviews&timestamp=1317327555&signature=a9718877bea71c2484f91608a7eaea1532bdf71f5c56825065fa4ccabe549ef3"
+
+	var proxy *AppProxyContext
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxy, _ = AppProxyFromContext(r.Context())
+	})
+
+	cases := []struct {
+		query          string
+		window         time.Duration
+		age            time.Duration
+		expectedStatus int
+	}{
+		{queryString, 0, 0, http.StatusOK},
+		{queryString + "&notok=true", 0, 0, http.StatusUnauthorized},
+		{queryString, 0, 4 * time.Minute, http.StatusOK},
+		{queryString, 0, 6 * time.Minute, http.StatusUnauthorized},
+		{queryString, 10 * time.Minute, 6 * time.Minute, http.StatusOK},
+		{queryString, time.Minute, 2 * time.Minute, http.StatusUnauthorized},
+	}
+
+	for _, c := range cases {
+		restore := setAppProxyNow(time.Unix(appProxyTestTimestamp, 0).Add(c.age))
+		proxy = nil
+		rec := httptest.NewRecorder()
+		app.AppProxyMiddleware(next, c.window).ServeHTTP(rec, httptest.NewRequest("GET", "/proxied?"+c.query, nil))
+		restore()
+
+		if rec.Code != c.expectedStatus {
+			t.Errorf("AppProxyMiddleware with window %s and age %s returned status %d, expected %d", c.window, c.age, rec.Code, c.expectedStatus)
+		}
+		if c.expectedStatus == http.StatusOK && (proxy == nil || proxy.Shop != "shop-name.myshopify.com" || proxy.PathPrefix != "/apps/awesome_reviews") {
+			t.Errorf("AppProxyFromContext returned %+v", proxy)
+		}
+	}
+}
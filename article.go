@@ -0,0 +1,222 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const articlesBasePath = "articles"
+const articlesResourceName = "articles"
+
+// ArticleService is an interface for interfacing with the article endpoints
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/article
+type ArticleService interface {
+	List(int64, interface{}) ([]Article, error)
+	ListWithPagination(int64, interface{}) ([]Article, *Pagination, error)
+	Count(int64, interface{}) (int, error)
+	Get(int64, int64, interface{}) (*Article, error)
+	Create(int64, Article) (*Article, error)
+	Update(int64, Article) (*Article, error)
+	Delete(int64, int64) error
+	ListAuthors() ([]string, error)
+	ListTags(interface{}) ([]string, error)
+	ListBlogTags(int64, interface{}) ([]string, error)
+
+	// MetafieldsService used for Article resource to communicate with
+	// Metafields resource
+	MetafieldsService
+}
+
+// ArticleServiceOp handles communication with the article related methods of
+// the Shopify API.
+type ArticleServiceOp struct {
+	client *Client
+}
+
+// Article represents a Shopify blog article
+type Article struct {
+	ID                int64         `json:"id,omitempty"`
+	BlogID            int64         `json:"blog_id,omitempty"`
+	UserID            int64         `json:"user_id,omitempty"`
+	Title             string        `json:"title,omitempty"`
+	Handle            string        `json:"handle,omitempty"`
+	Author            string        `json:"author,omitempty"`
+	BodyHTML          string        `json:"body_html,omitempty"`
+	SummaryHTML       *string       `json:"summary_html,omitempty"`
+	Tags              string        `json:"tags,omitempty"`
+	TemplateSuffix    string        `json:"template_suffix,omitempty"`
+	Image             *ArticleImage `json:"image,omitempty"`
+	Published         *bool         `json:"published,omitempty"`
+	PublishedAt       *time.Time    `json:"published_at,omitempty"`
+	CreatedAt         *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time    `json:"updated_at,omitempty"`
+	Metafields        []Metafield   `json:"metafields,omitempty"`
+	AdminGraphqlAPIID string        `json:"admin_graphql_api_id,omitempty"`
+}
+
+// ArticleImage is the image of an article. When creating or updating an
+// article, set either Src to the url of the image or Attachment to the base64
+// encoded image.
+type ArticleImage struct {
+	Src        string     `json:"src,omitempty"`
+	Attachment string     `json:"attachment,omitempty"`
+	Alt        string     `json:"alt,omitempty"`
+	Width      int        `json:"width,omitempty"`
+	Height     int        `json:"height,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// ArticleResource represents the result from the blogs/X/articles/Y.json endpoint
+type ArticleResource struct {
+	Article *Article `json:"article"`
+}
+
+// ArticlesResource represents the result from the blogs/X/articles.json endpoint
+type ArticlesResource struct {
+	Articles []Article `json:"articles"`
+}
+
+// A struct for all available article list options.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/article#get-blogs-blog-id-articles
+type ArticleListOptions struct {
+	ListOptions
+	Handle          string    `url:"handle,omitempty"`
+	Tag             string    `url:"tag,omitempty"`
+	Author          string    `url:"author,omitempty"`
+	PublishedStatus string    `url:"published_status,omitempty"`
+	PublishedAtMin  time.Time `url:"published_at_min,omitempty"`
+	PublishedAtMax  time.Time `url:"published_at_max,omitempty"`
+}
+
+// A struct for the article tags list options. Popular orders the tags by
+// popularity.
+type ArticleTagsOptions struct {
+	Limit   int `url:"limit,omitempty"`
+	Popular int `url:"popular,omitempty"`
+}
+
+// List articles of a blog
+func (s *ArticleServiceOp) List(blogID int64, options interface{}) ([]Article, error) {
+	articles, _, err := s.ListWithPagination(blogID, options)
+	if err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
+
+// ListWithPagination lists articles of a blog and return pagination to retrieve next/previous results.
+func (s *ArticleServiceOp) ListWithPagination(blogID int64, options interface{}) ([]Article, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", blogsBasePath, blogID, articlesBasePath)
+	resource := new(ArticlesResource)
+
+	pagination, err := s.client.ListWithPagination(path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Articles, pagination, nil
+}
+
+// Count articles of a blog
+func (s *ArticleServiceOp) Count(blogID int64, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/%d/%s/count.json", blogsBasePath, blogID, articlesBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual article
+func (s *ArticleServiceOp) Get(blogID int64, articleID int64, options interface{}) (*Article, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", blogsBasePath, blogID, articlesBasePath, articleID)
+	resource := new(ArticleResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Article, err
+}
+
+// Create a new article in a blog
+func (s *ArticleServiceOp) Create(blogID int64, article Article) (*Article, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", blogsBasePath, blogID, articlesBasePath)
+	wrappedData := ArticleResource{Article: &article}
+	resource := new(ArticleResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Article, err
+}
+
+// Update an existing article
+func (s *ArticleServiceOp) Update(blogID int64, article Article) (*Article, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", blogsBasePath, blogID, articlesBasePath, article.ID)
+	wrappedData := ArticleResource{Article: &article}
+	resource := new(ArticleResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Article, err
+}
+
+// Delete an existing article
+func (s *ArticleServiceOp) Delete(blogID int64, articleID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d/%s/%d.json", blogsBasePath, blogID, articlesBasePath, articleID))
+}
+
+// ListAuthors lists the authors of all articles
+func (s *ArticleServiceOp) ListAuthors() ([]string, error) {
+	path := fmt.Sprintf("%s/authors.json", articlesBasePath)
+	resource := struct {
+		Authors []string `json:"authors"`
+	}{}
+	err := s.client.Get(path, &resource, nil)
+	return resource.Authors, err
+}
+
+// ListTags lists the tags of all articles
+func (s *ArticleServiceOp) ListTags(options interface{}) ([]string, error) {
+	path := fmt.Sprintf("%s/tags.json", articlesBasePath)
+	return s.listTags(path, options)
+}
+
+// ListBlogTags lists the tags of the articles of a blog
+func (s *ArticleServiceOp) ListBlogTags(blogID int64, options interface{}) ([]string, error) {
+	path := fmt.Sprintf("%s/%d/%s/tags.json", blogsBasePath, blogID, articlesBasePath)
+	return s.listTags(path, options)
+}
+
+func (s *ArticleServiceOp) listTags(path string, options interface{}) ([]string, error) {
+	resource := struct {
+		Tags []string `json:"tags"`
+	}{}
+	err := s.client.Get(path, &resource, options)
+	return resource.Tags, err
+}
+
+// List metafields for an article
+func (s *ArticleServiceOp) ListMetafields(articleID int64, options interface{}) ([]Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.List(options)
+}
+
+// Count metafields for an article
+func (s *ArticleServiceOp) CountMetafields(articleID int64, options interface{}) (int, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Count(options)
+}
+
+// Get individual metafield for an article
+func (s *ArticleServiceOp) GetMetafield(articleID int64, metafieldID int64, options interface{}) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Get(metafieldID, options)
+}
+
+// Create a new metafield for an article
+func (s *ArticleServiceOp) CreateMetafield(articleID int64, metafield Metafield) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Create(metafield)
+}
+
+// Update an existing metafield for an article
+func (s *ArticleServiceOp) UpdateMetafield(articleID int64, metafield Metafield) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Update(metafield)
+}
+
+// Delete an existing metafield for an article
+func (s *ArticleServiceOp) DeleteMetafield(articleID int64, metafieldID int64) error {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Delete(metafieldID)
+}
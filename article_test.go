@@ -0,0 +1,256 @@
+package goshopify
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func articleTests(t *testing.T, article Article) {
+	expectedID := int64(134645308)
+	if article.ID != expectedID {
+		t.Errorf("Article.ID returned %+v, expected %+v", article.ID, expectedID)
+	}
+
+	expectedBlogID := int64(241253187)
+	if article.BlogID != expectedBlogID {
+		t.Errorf("Article.BlogID returned %+v, expected %+v", article.BlogID, expectedBlogID)
+	}
+
+	expectedTitle := "get on the train now"
+	if article.Title != expectedTitle {
+		t.Errorf("Article.Title returned %+v, expected %+v", article.Title, expectedTitle)
+	}
+
+	expectedTags := "Announcing, Mystery"
+	if article.Tags != expectedTags {
+		t.Errorf("Article.Tags returned %+v, expected %+v", article.Tags, expectedTags)
+	}
+
+	expectedPublishedAt := time.Date(2008, time.August, 1, 0, 0, 0, 0, time.UTC)
+	if article.PublishedAt == nil || !expectedPublishedAt.Equal(*article.PublishedAt) {
+		t.Errorf("Article.PublishedAt returned %+v, expected %+v", article.PublishedAt, expectedPublishedAt)
+	}
+}
+
+func TestArticleList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("articles.json")))
+
+	params := map[string]string{"tag": "Mystery", "published_status": "published"}
+	httpmock.RegisterResponderWithQuery("GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"articles": [{"id":134645308}]}`))
+
+	articles, err := client.Article.List(241253187, nil)
+	if err != nil {
+		t.Errorf("Article.List returned error: %v", err)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("Article.List returned %d articles, expected 2", len(articles))
+	}
+	articleTests(t, articles[0])
+
+	articles, err = client.Article.List(241253187, ArticleListOptions{Tag: "Mystery", PublishedStatus: "published"})
+	if err != nil {
+		t.Errorf("Article.List returned error: %v", err)
+	}
+
+	expected := []Article{{ID: 134645308}}
+	if !reflect.DeepEqual(articles, expected) {
+		t.Errorf("Article.List returned %+v, expected %+v", articles, expected)
+	}
+}
+
+func TestArticleCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 4}`))
+
+	cnt, err := client.Article.Count(241253187, nil)
+	if err != nil {
+		t.Errorf("Article.Count returned error: %v", err)
+	}
+
+	expected := 4
+	if cnt != expected {
+		t.Errorf("Article.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestArticleGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/134645308.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("article.json")))
+
+	article, err := client.Article.Get(241253187, 134645308, nil)
+	if err != nil {
+		t.Fatalf("Article.Get returned error: %v", err)
+	}
+
+	articleTests(t, *article)
+
+	expectedImage := "https://cdn.shopify.com/s/files/1/0005/4838/0009/articles/ipod.png?v=1696353839"
+	if article.Image == nil || article.Image.Src != expectedImage || article.Image.Width != 123 {
+		t.Errorf("Article.Image returned %+v, expected src %s", article.Image, expectedImage)
+	}
+}
+
+func TestArticleCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("article.json")))
+
+	published := true
+	article, err := client.Article.Create(241253187, Article{
+		Title:     "get on the train now",
+		Author:    "Dennis",
+		Tags:      "Announcing, Mystery",
+		BodyHTML:  "<p>Do <em>you</em> have an <strong>IPod</strong> yet?</p>",
+		Published: &published,
+		Image:     &ArticleImage{Src: "https://example.com/ipod.png", Alt: "iPod Nano"},
+	})
+	if err != nil {
+		t.Fatalf("Article.Create returned error: %v", err)
+	}
+
+	articleTests(t, *article)
+}
+
+func TestArticleUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/134645308.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("article.json")))
+
+	article, err := client.Article.Update(241253187, Article{ID: 134645308, Title: "get on the train now"})
+	if err != nil {
+		t.Fatalf("Article.Update returned error: %v", err)
+	}
+
+	articleTests(t, *article)
+}
+
+func TestArticleDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/134645308.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Article.Delete(241253187, 134645308)
+	if err != nil {
+		t.Errorf("Article.Delete returned error: %v", err)
+	}
+}
+
+func TestArticleListAuthors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/articles/authors.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"authors": ["Dennis", "John"]}`))
+
+	authors, err := client.Article.ListAuthors()
+	if err != nil {
+		t.Errorf("Article.ListAuthors returned error: %v", err)
+	}
+
+	expected := []string{"Dennis", "John"}
+	if !reflect.DeepEqual(authors, expected) {
+		t.Errorf("Article.ListAuthors returned %+v, expected %+v", authors, expected)
+	}
+}
+
+func TestArticleListTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"limit": "1", "popular": "1"}
+	httpmock.RegisterResponderWithQuery("GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/articles/tags.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"tags": ["Mystery"]}`))
+
+	tags, err := client.Article.ListTags(ArticleTagsOptions{Limit: 1, Popular: 1})
+	if err != nil {
+		t.Errorf("Article.ListTags returned error: %v", err)
+	}
+
+	expected := []string{"Mystery"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf("Article.ListTags returned %+v, expected %+v", tags, expected)
+	}
+}
+
+func TestArticleListBlogTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/tags.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"tags": ["Announcing", "Mystery"]}`))
+
+	tags, err := client.Article.ListBlogTags(241253187, nil)
+	if err != nil {
+		t.Errorf("Article.ListBlogTags returned error: %v", err)
+	}
+
+	expected := []string{"Announcing", "Mystery"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf("Article.ListBlogTags returned %+v, expected %+v", tags, expected)
+	}
+}
+
+func TestArticleListMetafields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/articles/1/metafields.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"metafields": [{"id":1},{"id":2}]}`))
+
+	metafields, err := client.Article.ListMetafields(1, nil)
+	if err != nil {
+		t.Errorf("Article.ListMetafields() returned error: %v", err)
+	}
+
+	expected := []Metafield{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(metafields, expected) {
+		t.Errorf("Article.ListMetafields() returned %+v, expected %+v", metafields, expected)
+	}
+}
+
+func TestArticleCreateMetafield(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/articles/1/metafields.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("metafield.json")))
+
+	metafield := Metafield{
+		Key:       "app_key",
+		Value:     "app_value",
+		Type:      MetafieldTypeSingleLineTextField,
+		Namespace: "affiliates",
+	}
+
+	returnedMetafield, err := client.Article.CreateMetafield(1, metafield)
+	if err != nil {
+		t.Errorf("Article.CreateMetafield() returned error: %v", err)
+	}
+
+	MetafieldTests(t, *returnedMetafield)
+}
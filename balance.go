@@ -0,0 +1,139 @@
+package goshopify
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const balanceBasePath = "shopify_payments/balance"
+
+// BalanceService is an interface for interfacing with the Shopify Payments
+// balance endpoint of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/balance
+type BalanceService interface {
+	List() ([]Balance, error)
+	Reconcile() ([]BalanceReconciliation, error)
+}
+
+// BalanceServiceOp handles communication with the balance related methods of
+// the Shopify API.
+type BalanceServiceOp struct {
+	client *Client
+}
+
+// Balance represents the Shopify Payments balance in a currency
+type Balance struct {
+	Amount   decimal.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// BalanceResource represents the result from the shopify_payments/balance.json endpoint
+type BalanceResource struct {
+	Balance []Balance `json:"balance"`
+}
+
+// BalanceReconciliation puts the balance of a currency next to the payouts
+// that are scheduled or in transit and the balance transactions not yet
+// assigned to a payout. Difference is the balance minus the amount of those
+// payouts and the net of those transactions, zero when they account for the
+// whole balance.
+type BalanceReconciliation struct {
+	Currency               string
+	Balance                decimal.Decimal
+	PendingPayouts         []Payout
+	PendingPayoutsAmount   decimal.Decimal
+	PendingTransactions    []PaymentsTransactions
+	PendingTransactionsNet decimal.Decimal
+	Difference             decimal.Decimal
+}
+
+// List the balance in each currency
+func (s *BalanceServiceOp) List() ([]Balance, error) {
+	path := fmt.Sprintf("%s.json", balanceBasePath)
+	resource := new(BalanceResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.Balance, err
+}
+
+// Reconcile gets the balance, the pending payouts and the pending balance
+// transactions and groups them by currency
+func (s *BalanceServiceOp) Reconcile() ([]BalanceReconciliation, error) {
+	balances, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var payouts []Payout
+	for _, status := range []PayoutStatus{PayoutStatusScheduled, PayoutStatusInTransit} {
+		var options interface{} = PayoutsListOptions{Status: status}
+		for options != nil {
+			page, pagination, err := s.client.Payouts.ListWithPagination(options)
+			if err != nil {
+				return nil, err
+			}
+			payouts = append(payouts, page...)
+			options = nextPageOptions(pagination)
+		}
+	}
+
+	var transactions []PaymentsTransactions
+	var options interface{} = PaymentsTransactionsListOptions{PayoutStatus: PayoutStatusPending}
+	for options != nil {
+		page, pagination, err := s.client.PaymentsTransactions.ListWithPagination(options)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, page...)
+		options = nextPageOptions(pagination)
+	}
+
+	var reconciliations []BalanceReconciliation
+	byCurrency := map[string]int{}
+	reconciliation := func(currency string) *BalanceReconciliation {
+		i, ok := byCurrency[currency]
+		if !ok {
+			i = len(reconciliations)
+			byCurrency[currency] = i
+			reconciliations = append(reconciliations, BalanceReconciliation{Currency: currency})
+		}
+		return &reconciliations[i]
+	}
+
+	for _, balance := range balances {
+		r := reconciliation(balance.Currency)
+		r.Balance = r.Balance.Add(balance.Amount)
+	}
+
+	for _, payout := range payouts {
+		r := reconciliation(payout.Currency)
+		r.PendingPayouts = append(r.PendingPayouts, payout)
+		r.PendingPayoutsAmount = r.PendingPayoutsAmount.Add(payout.Amount)
+	}
+
+	for _, transaction := range transactions {
+		net, err := decimal.NewFromString(transaction.Net)
+		if err != nil {
+			return nil, fmt.Errorf("balance transaction %d has invalid net %q: %v", transaction.Id, transaction.Net, err)
+		}
+		r := reconciliation(transaction.Currency)
+		r.PendingTransactions = append(r.PendingTransactions, transaction)
+		r.PendingTransactionsNet = r.PendingTransactionsNet.Add(net)
+	}
+
+	for i := range reconciliations {
+		r := &reconciliations[i]
+		r.Difference = r.Balance.Sub(r.PendingPayoutsAmount).Sub(r.PendingTransactionsNet)
+	}
+
+	return reconciliations, nil
+}
+
+// nextPageOptions returns the options to fetch the next page, or nil on the
+// last page
+func nextPageOptions(pagination *Pagination) interface{} {
+	if pagination == nil || pagination.NextPageOptions == nil {
+		return nil
+	}
+	return pagination.NextPageOptions
+}
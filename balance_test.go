@@ -0,0 +1,112 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func TestBalanceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/balance.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("balance.json")))
+
+	balance, err := client.Balance.List()
+	if err != nil {
+		t.Errorf("Balance.List returned error: %v", err)
+	}
+
+	expected := []Balance{
+		{Amount: decimal.RequireFromString("53.99"), Currency: "USD"},
+		{Amount: decimal.RequireFromString("0.00"), Currency: "CAD"},
+	}
+	if !reflect.DeepEqual(balance, expected) {
+		t.Errorf("Balance.List returned %+v, expected %+v", balance, expected)
+	}
+}
+
+func TestBalanceReconcile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/balance.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("balance.json")))
+
+	payoutsURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/payouts.json", client.pathPrefix)
+	httpmock.RegisterResponderWithQuery("GET", payoutsURL, map[string]string{"status": "scheduled"},
+		httpmock.NewStringResponder(200, `{"payouts": [{"id":1,"status":"scheduled","currency":"USD","amount":"20.00","date":"2023-10-05"}]}`))
+	httpmock.RegisterResponderWithQuery("GET", payoutsURL, map[string]string{"status": "in_transit"},
+		httpmock.NewStringResponder(200, `{"payouts": [{"id":2,"status":"in_transit","currency":"USD","amount":"5.50","date":"2023-10-04"},{"id":6,"status":"in_transit","currency":"EUR","amount":"2.00","date":"2023-10-04"}]}`))
+
+	transactionsURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/balance/transactions.json", client.pathPrefix)
+	httpmock.RegisterResponderWithQuery("GET", transactionsURL, map[string]string{"payout_status": "pending"},
+		httpmock.ResponderFromResponse(&http.Response{
+			StatusCode: 200,
+			Body:       httpmock.NewRespBodyFromString(`{"transactions": [{"id":3,"payout_status":"pending","currency":"USD","net":"25.00"}]}`),
+			Header: http.Header{
+				"Link": {`<http://valid.url?page_info=pageInfoCode>; rel="next"`},
+			},
+		}))
+	httpmock.RegisterResponderWithQuery("GET", transactionsURL, map[string]string{"page_info": "pageInfoCode"},
+		httpmock.NewStringResponder(200, `{"transactions": [{"id":4,"payout_status":"pending","currency":"USD","net":"3.49"},{"id":5,"payout_status":"pending","currency":"EUR","net":"1.00"}]}`))
+
+	reconciliations, err := client.Balance.Reconcile()
+	if err != nil {
+		t.Fatalf("Balance.Reconcile returned error: %v", err)
+	}
+	if len(reconciliations) != 3 {
+		t.Fatalf("Balance.Reconcile returned %d currencies, expected 3", len(reconciliations))
+	}
+
+	usd := reconciliations[0]
+	if usd.Currency != "USD" || len(usd.PendingPayouts) != 2 || len(usd.PendingTransactions) != 2 {
+		t.Errorf("Balance.Reconcile returned %+v, expected USD with 2 payouts and 2 transactions", usd)
+	}
+
+	cases := []struct {
+		field    string
+		expected decimal.Decimal
+		actual   decimal.Decimal
+	}{
+		{"USD.Balance", decimal.NewFromFloat(53.99), usd.Balance},
+		{"USD.PendingPayoutsAmount", decimal.NewFromFloat(25.50), usd.PendingPayoutsAmount},
+		{"USD.PendingTransactionsNet", decimal.NewFromFloat(28.49), usd.PendingTransactionsNet},
+		{"USD.Difference", decimal.Zero, usd.Difference},
+		{"CAD.Balance", decimal.Zero, reconciliations[1].Balance},
+		{"EUR.PendingPayoutsAmount", decimal.NewFromFloat(2), reconciliations[2].PendingPayoutsAmount},
+		{"EUR.Difference", decimal.NewFromFloat(-3), reconciliations[2].Difference},
+	}
+	for _, c := range cases {
+		if !c.expected.Equal(c.actual) {
+			t.Errorf("Balance.Reconcile %s returned %s, expected %s", c.field, c.actual, c.expected)
+		}
+	}
+
+	if reconciliations[1].Currency != "CAD" || reconciliations[2].Currency != "EUR" {
+		t.Errorf("Balance.Reconcile returned currencies %s and %s, expected CAD and EUR", reconciliations[1].Currency, reconciliations[2].Currency)
+	}
+}
+
+func TestBalanceReconcileError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/balance.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("balance.json")))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/payouts.json", client.pathPrefix),
+		httpmock.NewStringResponder(500, ""))
+
+	reconciliations, err := client.Balance.Reconcile()
+	if err == nil {
+		t.Errorf("Balance.Reconcile returned success, expected error")
+	}
+	if reconciliations != nil {
+		t.Errorf("Balance.Reconcile returned %+v, expected nil", reconciliations)
+	}
+}
@@ -0,0 +1,97 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func cancellationRequestResponder(t *testing.T, expectedMessage string, body []byte) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resource := CancellationRequestResource{}
+		if err := json.NewDecoder(req.Body).Decode(&resource); err != nil {
+			return nil, err
+		}
+		if resource.CancellationRequest.Message != expectedMessage {
+			t.Errorf("CancellationRequest sent message %q, expected %q", resource.CancellationRequest.Message, expectedMessage)
+		}
+		return httpmock.NewBytesResponse(200, body), nil
+	}
+}
+
+func TestCancellationRequestServiceOp_Send(t *testing.T) {
+	setup()
+	defer teardown()
+
+	fulfillmentOrderID := int64(1046000831)
+	message := "The customer changed his mind."
+	httpmock.RegisterResponder(
+		http.MethodPost,
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/%d/cancellation_request.json", client.pathPrefix, fulfillmentOrderID),
+		cancellationRequestResponder(t, message, loadFixture("cancellation_request.json")),
+	)
+
+	result, err := client.CancellationRequest.Send(fulfillmentOrderID, CancellationRequest{Message: message})
+	if err != nil {
+		t.Fatalf("CancellationRequest.Send returned error: %v", err)
+	}
+
+	if result.Id != fulfillmentOrderID || result.RequestStatus != "cancellation_requested" || result.Status != "in_progress" {
+		t.Errorf("CancellationRequest.Send returned %+v, expected a fulfillment order with a requested cancellation", result)
+	}
+
+	if len(result.MerchantRequests) != 1 || result.MerchantRequests[0].Kind != "cancellation_request" || result.MerchantRequests[0].Message != message {
+		t.Errorf("CancellationRequest.Send returned merchant requests %+v, expected the cancellation request", result.MerchantRequests)
+	}
+
+	if len(result.LineItems) != 1 || result.LineItems[0].FulfillmentOrderId != fulfillmentOrderID {
+		t.Errorf("CancellationRequest.Send returned line items %+v, expected 1 line item", result.LineItems)
+	}
+}
+
+func TestCancellationRequestServiceOp_Accept(t *testing.T) {
+	setup()
+	defer teardown()
+
+	fulfillmentOrderID := int64(1046000832)
+	message := "We had not started any processing yet."
+	httpmock.RegisterResponder(
+		http.MethodPost,
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/%d/cancellation_request/accept.json", client.pathPrefix, fulfillmentOrderID),
+		cancellationRequestResponder(t, message, []byte(`{"fulfillment_order": {"id":1046000832,"request_status":"cancellation_accepted","status":"cancelled"}}`)),
+	)
+
+	result, err := client.CancellationRequest.Accept(fulfillmentOrderID, CancellationRequest{Message: message})
+	if err != nil {
+		t.Fatalf("CancellationRequest.Accept returned error: %v", err)
+	}
+
+	if result.Id != fulfillmentOrderID || result.RequestStatus != "cancellation_accepted" || result.Status != "cancelled" {
+		t.Errorf("CancellationRequest.Accept returned %+v, expected a cancelled fulfillment order", result)
+	}
+}
+
+func TestCancellationRequestServiceOp_Reject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	fulfillmentOrderID := int64(1046000833)
+	message := "We have already sent the shipment out."
+	httpmock.RegisterResponder(
+		http.MethodPost,
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/%d/cancellation_request/reject.json", client.pathPrefix, fulfillmentOrderID),
+		cancellationRequestResponder(t, message, []byte(`{"fulfillment_order": {"id":1046000833,"request_status":"cancellation_rejected","status":"in_progress"}}`)),
+	)
+
+	result, err := client.CancellationRequest.Reject(fulfillmentOrderID, CancellationRequest{Message: message})
+	if err != nil {
+		t.Fatalf("CancellationRequest.Reject returned error: %v", err)
+	}
+
+	if result.Id != fulfillmentOrderID || result.RequestStatus != "cancellation_rejected" || result.Status != "in_progress" {
+		t.Errorf("CancellationRequest.Reject returned %+v, expected a fulfillment order still in progress", result)
+	}
+}
@@ -0,0 +1,123 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const collectionListingBasePath = "collection_listings"
+
+// CollectionListingService is an interface for interfacing with the collection
+// listing endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/collectionlisting
+type CollectionListingService interface {
+	List(interface{}) ([]CollectionListing, error)
+	ListWithPagination(interface{}) ([]CollectionListing, *Pagination, error)
+	Get(int64, interface{}) (*CollectionListing, error)
+	GetProductIDs(int64, interface{}) ([]int64, error)
+	Publish(int64) (*CollectionListing, error)
+	Delete(int64) error
+}
+
+// CollectionListingServiceOp handles communication with the collection
+// listing related methods of the Shopify API.
+type CollectionListingServiceOp struct {
+	client *Client
+}
+
+// CollectionListing represents a Shopify collection published to your sales channel app
+type CollectionListing struct {
+	ID                  int64      `json:"collection_id,omitempty"`
+	Title               string     `json:"title,omitempty"`
+	BodyHTML            string     `json:"body_html,omitempty"`
+	Handle              string     `json:"handle,omitempty"`
+	SortOrder           string     `json:"sort_order,omitempty"`
+	Image               *Image     `json:"image,omitempty"`
+	DefaultProductImage *Image     `json:"default_product_image,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+	PublishedAt         *time.Time `json:"published_at,omitempty"`
+}
+
+// Represents the result from the collection_listings/X.json endpoint
+type CollectionListingResource struct {
+	CollectionListing *CollectionListing `json:"collection_listing"`
+}
+
+// Represents the result from the collection_listings.json endpoint
+type CollectionListingsResource struct {
+	CollectionListings []CollectionListing `json:"collection_listings"`
+}
+
+// Represents the result from the collection_listings/X/product_ids.json endpoint
+type CollectionListingProductIDsResource struct {
+	ProductIDs []int64 `json:"product_ids"`
+}
+
+// Resource which create collection_listing endpoint expects in request body
+// e.g.
+// PUT /admin/api/2023-10/collection_listings/482865238.json
+//
+//	{
+//	  "collection_listing": {
+//	    "collection_id": 482865238
+//	  }
+//	}
+type CollectionListingPublishResource struct {
+	CollectionListing struct {
+		CollectionID int64 `json:"collection_id"`
+	} `json:"collection_listing"`
+}
+
+// List collection listings
+func (s *CollectionListingServiceOp) List(options interface{}) ([]CollectionListing, error) {
+	collections, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return collections, nil
+}
+
+// ListWithPagination lists collection listings and return pagination to retrieve next/previous results.
+func (s *CollectionListingServiceOp) ListWithPagination(options interface{}) ([]CollectionListing, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", collectionListingBasePath)
+	resource := new(CollectionListingsResource)
+
+	pagination, err := s.client.ListWithPagination(path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.CollectionListings, pagination, nil
+}
+
+// Get individual collection_listing by collection ID
+func (s *CollectionListingServiceOp) Get(collectionID int64, options interface{}) (*CollectionListing, error) {
+	path := fmt.Sprintf("%s/%d.json", collectionListingBasePath, collectionID)
+	resource := new(CollectionListingResource)
+	err := s.client.Get(path, resource, options)
+	return resource.CollectionListing, err
+}
+
+// GetProductIDs lists the IDs of the products of a collection that are
+// published to your sales channel
+func (s *CollectionListingServiceOp) GetProductIDs(collectionID int64, options interface{}) ([]int64, error) {
+	path := fmt.Sprintf("%s/%d/product_ids.json", collectionListingBasePath, collectionID)
+	resource := new(CollectionListingProductIDsResource)
+	err := s.client.Get(path, resource, options)
+	return resource.ProductIDs, err
+}
+
+// Publish an existing collection listing to your sales channel app
+func (s *CollectionListingServiceOp) Publish(collectionID int64) (*CollectionListing, error) {
+	path := fmt.Sprintf("%s/%d.json", collectionListingBasePath, collectionID)
+	wrappedData := new(CollectionListingPublishResource)
+	wrappedData.CollectionListing.CollectionID = collectionID
+	resource := new(CollectionListingResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.CollectionListing, err
+}
+
+// Delete unpublishes an existing collection from your sales channel app.
+func (s *CollectionListingServiceOp) Delete(collectionID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", collectionListingBasePath, collectionID))
+}
@@ -0,0 +1,158 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func collectionListingTests(t *testing.T, collection CollectionListing) {
+	expectedID := int64(482865238)
+	if collection.ID != expectedID {
+		t.Errorf("CollectionListing.ID returned %+v, expected %+v", collection.ID, expectedID)
+	}
+
+	expectedHandle := "smart-ipods"
+	if collection.Handle != expectedHandle {
+		t.Errorf("CollectionListing.Handle returned %+v, expected %+v", collection.Handle, expectedHandle)
+	}
+
+	if collection.Image == nil || collection.Image.Width != 123 {
+		t.Errorf("CollectionListing.Image returned %+v, expected width 123", collection.Image)
+	}
+
+	if collection.DefaultProductImage != nil {
+		t.Errorf("CollectionListing.DefaultProductImage returned %+v, expected nil", collection.DefaultProductImage)
+	}
+}
+
+func TestCollectionListingList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"collection_listings": [{"collection_id":1},{"collection_id":2}]}`))
+
+	collections, err := client.CollectionListing.List(nil)
+	if err != nil {
+		t.Errorf("CollectionListing.List returned error: %v", err)
+	}
+
+	expected := []CollectionListing{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(collections, expected) {
+		t.Errorf("CollectionListing.List returned %+v, expected %+v", collections, expected)
+	}
+}
+
+func TestCollectionListingListError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings.json", client.pathPrefix),
+		httpmock.NewStringResponder(500, ""))
+
+	expectedErrMessage := "Unknown Error"
+
+	collections, err := client.CollectionListing.List(nil)
+	if collections != nil {
+		t.Errorf("CollectionListing.List returned collections, expected nil: %v", err)
+	}
+
+	if err == nil || err.Error() != expectedErrMessage {
+		t.Errorf("CollectionListing.List err returned %+v, expected %+v", err, expectedErrMessage)
+	}
+}
+
+func TestCollectionListingListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, httpmock.ResponderFromResponse(&http.Response{
+		StatusCode: 200,
+		Body:       httpmock.NewRespBodyFromString(`{"collection_listings": [{"collection_id":1},{"collection_id":2}]}`),
+		Header: http.Header{
+			"Link": {`<http://valid.url?page_info=pageInfoCode&limit=2>; rel="next"`},
+		},
+	}))
+
+	collections, pagination, err := client.CollectionListing.ListWithPagination(&ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("CollectionListing.ListWithPagination returned error: %v", err)
+	}
+
+	expected := []CollectionListing{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(collections, expected) {
+		t.Errorf("CollectionListing.ListWithPagination returned %+v, expected %+v", collections, expected)
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pageInfoCode", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("CollectionListing.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestCollectionListingGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings/482865238.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("collection_listing.json")))
+
+	collection, err := client.CollectionListing.Get(482865238, nil)
+	if err != nil {
+		t.Fatalf("CollectionListing.Get returned error: %v", err)
+	}
+
+	collectionListingTests(t, *collection)
+}
+
+func TestCollectionListingGetProductIDs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings/482865238/product_ids.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"product_ids": [1,2,3]}`))
+
+	productIDs, err := client.CollectionListing.GetProductIDs(482865238, nil)
+	if err != nil {
+		t.Errorf("CollectionListing.GetProductIDs returned error: %v", err)
+	}
+
+	expected := []int64{1, 2, 3}
+	if !reflect.DeepEqual(productIDs, expected) {
+		t.Errorf("CollectionListing.GetProductIDs returned %+v, expected %+v", productIDs, expected)
+	}
+}
+
+func TestCollectionListingPublish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings/482865238.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("collection_listing.json")))
+
+	collection, err := client.CollectionListing.Publish(482865238)
+	if err != nil {
+		t.Fatalf("CollectionListing.Publish returned error: %v", err)
+	}
+
+	collectionListingTests(t, *collection)
+}
+
+func TestCollectionListingDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings/1.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CollectionListing.Delete(1)
+	if err != nil {
+		t.Errorf("CollectionListing.Delete returned error: %v", err)
+	}
+}
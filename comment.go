@@ -0,0 +1,166 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const commentsBasePath = "comments"
+
+// CommentService is an interface for interfacing with the comment endpoints
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/comment
+type CommentService interface {
+	List(interface{}) ([]Comment, error)
+	ListWithPagination(interface{}) ([]Comment, *Pagination, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Comment, error)
+	Create(Comment) (*Comment, error)
+	Update(Comment) (*Comment, error)
+	Approve(int64) (*Comment, error)
+	Spam(int64) (*Comment, error)
+	NotSpam(int64) (*Comment, error)
+	Remove(int64) (*Comment, error)
+	Restore(int64) (*Comment, error)
+}
+
+// CommentServiceOp handles communication with the comment related methods of
+// the Shopify API.
+type CommentServiceOp struct {
+	client *Client
+}
+
+type commentStatus string
+
+const (
+	CommentStatusPending    commentStatus = "pending"
+	CommentStatusPublished  commentStatus = "published"
+	CommentStatusUnapproved commentStatus = "unapproved"
+	CommentStatusSpam       commentStatus = "spam"
+	CommentStatusRemoved    commentStatus = "removed"
+)
+
+// Comment represents a comment on a Shopify blog article
+type Comment struct {
+	ID          int64         `json:"id,omitempty"`
+	ArticleID   int64         `json:"article_id,omitempty"`
+	BlogID      int64         `json:"blog_id,omitempty"`
+	Author      string        `json:"author,omitempty"`
+	Email       string        `json:"email,omitempty"`
+	Body        string        `json:"body,omitempty"`
+	BodyHTML    string        `json:"body_html,omitempty"`
+	Status      commentStatus `json:"status,omitempty"`
+	IP          string        `json:"ip,omitempty"`
+	UserAgent   string        `json:"user_agent,omitempty"`
+	PublishedAt *time.Time    `json:"published_at,omitempty"`
+	CreatedAt   *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time    `json:"updated_at,omitempty"`
+}
+
+// CommentResource represents the result from the comments/X.json endpoint
+type CommentResource struct {
+	Comment *Comment `json:"comment"`
+}
+
+// CommentsResource represents the result from the comments.json endpoint
+type CommentsResource struct {
+	Comments []Comment `json:"comments"`
+}
+
+// A struct for all available comment list options.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/comment#get-comments
+type CommentListOptions struct {
+	ListOptions
+	ArticleID       int64         `url:"article_id,omitempty"`
+	BlogID          int64         `url:"blog_id,omitempty"`
+	Status          commentStatus `url:"status,omitempty"`
+	PublishedStatus string        `url:"published_status,omitempty"`
+	PublishedAtMin  time.Time     `url:"published_at_min,omitempty"`
+	PublishedAtMax  time.Time     `url:"published_at_max,omitempty"`
+}
+
+// List comments
+func (s *CommentServiceOp) List(options interface{}) ([]Comment, error) {
+	comments, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// ListWithPagination lists comments and return pagination to retrieve next/previous results.
+func (s *CommentServiceOp) ListWithPagination(options interface{}) ([]Comment, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", commentsBasePath)
+	resource := new(CommentsResource)
+
+	pagination, err := s.client.ListWithPagination(path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Comments, pagination, nil
+}
+
+// Count comments
+func (s *CommentServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", commentsBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual comment
+func (s *CommentServiceOp) Get(commentID int64, options interface{}) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d.json", commentsBasePath, commentID)
+	resource := new(CommentResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Comment, err
+}
+
+// Create a new comment on an article
+func (s *CommentServiceOp) Create(comment Comment) (*Comment, error) {
+	path := fmt.Sprintf("%s.json", commentsBasePath)
+	wrappedData := CommentResource{Comment: &comment}
+	resource := new(CommentResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Comment, err
+}
+
+// Update an existing comment
+func (s *CommentServiceOp) Update(comment Comment) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d.json", commentsBasePath, comment.ID)
+	wrappedData := CommentResource{Comment: &comment}
+	resource := new(CommentResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Comment, err
+}
+
+// Approve a comment, publishing it
+func (s *CommentServiceOp) Approve(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "approve")
+}
+
+// Spam marks a comment as spam
+func (s *CommentServiceOp) Spam(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "spam")
+}
+
+// NotSpam marks a comment as not spam, restoring it to published or pending
+func (s *CommentServiceOp) NotSpam(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "not_spam")
+}
+
+// Remove a comment
+func (s *CommentServiceOp) Remove(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "remove")
+}
+
+// Restore a removed comment
+func (s *CommentServiceOp) Restore(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "restore")
+}
+
+func (s *CommentServiceOp) moderate(commentID int64, action string) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", commentsBasePath, commentID, action)
+	resource := new(CommentResource)
+	err := s.client.Post(path, nil, resource)
+	return resource.Comment, err
+}
@@ -0,0 +1,176 @@
+package goshopify
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func commentTests(t *testing.T, comment Comment) {
+	expectedID := int64(653537639)
+	if comment.ID != expectedID {
+		t.Errorf("Comment.ID returned %+v, expected %+v", comment.ID, expectedID)
+	}
+
+	expectedArticleID := int64(134645308)
+	if comment.ArticleID != expectedArticleID {
+		t.Errorf("Comment.ArticleID returned %+v, expected %+v", comment.ArticleID, expectedArticleID)
+	}
+
+	expectedAuthor := "Soleone"
+	if comment.Author != expectedAuthor {
+		t.Errorf("Comment.Author returned %+v, expected %+v", comment.Author, expectedAuthor)
+	}
+
+	if comment.Status != CommentStatusUnapproved {
+		t.Errorf("Comment.Status returned %+v, expected %+v", comment.Status, CommentStatusUnapproved)
+	}
+
+	expectedCreatedAt := time.Date(2023, time.October, 3, 17, 32, 36, 0, time.UTC)
+	if comment.CreatedAt == nil || !expectedCreatedAt.Equal(*comment.CreatedAt) {
+		t.Errorf("Comment.CreatedAt returned %+v, expected %+v", comment.CreatedAt, expectedCreatedAt)
+	}
+
+	if comment.PublishedAt != nil {
+		t.Errorf("Comment.PublishedAt returned %+v, expected nil", comment.PublishedAt)
+	}
+}
+
+func TestCommentList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("comments.json")))
+
+	params := map[string]string{"article_id": "134645308", "status": "spam"}
+	httpmock.RegisterResponderWithQuery("GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/comments.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"comments": [{"id":1,"status":"spam"}]}`))
+
+	comments, err := client.Comment.List(nil)
+	if err != nil {
+		t.Errorf("Comment.List returned error: %v", err)
+	}
+	if len(comments) != 1 {
+		t.Fatalf("Comment.List returned %d comments, expected 1", len(comments))
+	}
+	commentTests(t, comments[0])
+
+	comments, err = client.Comment.List(CommentListOptions{ArticleID: 134645308, Status: CommentStatusSpam})
+	if err != nil {
+		t.Errorf("Comment.List returned error: %v", err)
+	}
+
+	expected := []Comment{{ID: 1, Status: CommentStatusSpam}}
+	if !reflect.DeepEqual(comments, expected) {
+		t.Errorf("Comment.List returned %+v, expected %+v", comments, expected)
+	}
+}
+
+func TestCommentCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.Comment.Count(nil)
+	if err != nil {
+		t.Errorf("Comment.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("Comment.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCommentGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/653537639.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("comment.json")))
+
+	comment, err := client.Comment.Get(653537639, nil)
+	if err != nil {
+		t.Fatalf("Comment.Get returned error: %v", err)
+	}
+
+	commentTests(t, *comment)
+}
+
+func TestCommentCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("comment.json")))
+
+	comment, err := client.Comment.Create(Comment{
+		Body:      "Hi author, I really _like_ what you're doing there.",
+		Author:    "Soleone",
+		Email:     "sole@one.de",
+		IP:        "127.0.0.1",
+		BlogID:    241253187,
+		ArticleID: 134645308,
+	})
+	if err != nil {
+		t.Fatalf("Comment.Create returned error: %v", err)
+	}
+
+	commentTests(t, *comment)
+}
+
+func TestCommentUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/653537639.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("comment.json")))
+
+	comment, err := client.Comment.Update(Comment{ID: 653537639, Author: "Soleone"})
+	if err != nil {
+		t.Fatalf("Comment.Update returned error: %v", err)
+	}
+
+	commentTests(t, *comment)
+}
+
+func TestCommentModeration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		action   string
+		moderate func(int64) (*Comment, error)
+		status   commentStatus
+	}{
+		{"approve", client.Comment.Approve, CommentStatusPublished},
+		{"spam", client.Comment.Spam, CommentStatusSpam},
+		{"not_spam", client.Comment.NotSpam, CommentStatusPublished},
+		{"remove", client.Comment.Remove, CommentStatusRemoved},
+		{"restore", client.Comment.Restore, CommentStatusPublished},
+	}
+
+	for _, c := range cases {
+		httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/653537639/%s.json", client.pathPrefix, c.action),
+			httpmock.NewStringResponder(201, fmt.Sprintf(`{"comment": {"id":653537639,"status":"%s"}}`, c.status)))
+
+		comment, err := c.moderate(653537639)
+		if err != nil {
+			t.Errorf("Comment %s returned error: %v", c.action, err)
+			continue
+		}
+
+		expected := &Comment{ID: 653537639, Status: c.status}
+		if !reflect.DeepEqual(comment, expected) {
+			t.Errorf("Comment %s returned %+v, expected %+v", c.action, comment, expected)
+		}
+	}
+}
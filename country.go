@@ -0,0 +1,93 @@
+package goshopify
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const countriesBasePath = "countries"
+
+// CountryService is an interface for interfacing with the country endpoints
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/country
+type CountryService interface {
+	List(interface{}) ([]Country, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Country, error)
+	Create(Country) (*Country, error)
+	Update(Country) (*Country, error)
+	Delete(int64) error
+}
+
+// CountryServiceOp handles communication with the country related methods of
+// the Shopify API.
+type CountryServiceOp struct {
+	client *Client
+}
+
+// Country represents a country the store ships to, with its tax rate.
+// Tax is a rate, e.g. 0.05 for 5%.
+type Country struct {
+	ID        int64            `json:"id,omitempty"`
+	Code      string           `json:"code,omitempty"`
+	Name      string           `json:"name,omitempty"`
+	Tax       *decimal.Decimal `json:"tax,omitempty"`
+	TaxName   string           `json:"tax_name,omitempty"`
+	Provinces []Province       `json:"provinces,omitempty"`
+}
+
+// CountryResource represents the result from the countries/X.json endpoint
+type CountryResource struct {
+	Country *Country `json:"country"`
+}
+
+// CountriesResource represents the result from the countries.json endpoint
+type CountriesResource struct {
+	Countries []Country `json:"countries"`
+}
+
+// List countries
+func (s *CountryServiceOp) List(options interface{}) ([]Country, error) {
+	path := fmt.Sprintf("%s.json", countriesBasePath)
+	resource := new(CountriesResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Countries, err
+}
+
+// Count countries
+func (s *CountryServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", countriesBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual country
+func (s *CountryServiceOp) Get(countryID int64, options interface{}) (*Country, error) {
+	path := fmt.Sprintf("%s/%d.json", countriesBasePath, countryID)
+	resource := new(CountryResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Country, err
+}
+
+// Create a new country from its code, its provinces are created with it
+func (s *CountryServiceOp) Create(country Country) (*Country, error) {
+	path := fmt.Sprintf("%s.json", countriesBasePath)
+	wrappedData := CountryResource{Country: &country}
+	resource := new(CountryResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Country, err
+}
+
+// Update an existing country
+func (s *CountryServiceOp) Update(country Country) (*Country, error) {
+	path := fmt.Sprintf("%s/%d.json", countriesBasePath, country.ID)
+	wrappedData := CountryResource{Country: &country}
+	resource := new(CountryResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Country, err
+}
+
+// Delete an existing country
+func (s *CountryServiceOp) Delete(countryID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", countriesBasePath, countryID))
+}
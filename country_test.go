@@ -0,0 +1,151 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func countryTests(t *testing.T, country Country) {
+	expectedID := int64(879921427)
+	if country.ID != expectedID {
+		t.Errorf("Country.ID returned %+v, expected %+v", country.ID, expectedID)
+	}
+
+	expectedCode := "CA"
+	if country.Code != expectedCode {
+		t.Errorf("Country.Code returned %+v, expected %+v", country.Code, expectedCode)
+	}
+
+	expectedTaxName := "GST"
+	if country.TaxName != expectedTaxName {
+		t.Errorf("Country.TaxName returned %+v, expected %+v", country.TaxName, expectedTaxName)
+	}
+
+	expectedTax := decimal.NewFromFloat(0.05)
+	if country.Tax == nil || !country.Tax.Equal(expectedTax) {
+		t.Errorf("Country.Tax returned %+v, expected %+v", country.Tax, expectedTax)
+	}
+
+	if len(country.Provinces) == 0 {
+		t.Fatalf("Country.Provinces returned no provinces")
+	}
+
+	expectedProvinceTax := decimal.NewFromFloat(0.08)
+	province := country.Provinces[0]
+	if province.Code != "AB" || province.Tax == nil || !province.Tax.Equal(expectedProvinceTax) {
+		t.Errorf("Country.Provinces[0] returned %+v, expected code AB with tax %s", province, expectedProvinceTax)
+	}
+}
+
+func TestCountryList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("countries.json")))
+
+	countries, err := client.Country.List(nil)
+	if err != nil {
+		t.Errorf("Country.List returned error: %v", err)
+	}
+	if len(countries) != 2 {
+		t.Fatalf("Country.List returned %d countries, expected 2", len(countries))
+	}
+	countryTests(t, countries[0])
+
+	if countries[1].Tax == nil || !countries[1].Tax.IsZero() {
+		t.Errorf("Country.List returned tax %+v for %s, expected 0", countries[1].Tax, countries[1].Code)
+	}
+}
+
+func TestCountryCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	cnt, err := client.Country.Count(nil)
+	if err != nil {
+		t.Errorf("Country.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("Country.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCountryGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("country.json")))
+
+	country, err := client.Country.Get(879921427, nil)
+	if err != nil {
+		t.Fatalf("Country.Get returned error: %v", err)
+	}
+
+	countryTests(t, *country)
+}
+
+func TestCountryCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("country.json")))
+
+	country, err := client.Country.Create(Country{Code: "CA"})
+	if err != nil {
+		t.Fatalf("Country.Create returned error: %v", err)
+	}
+
+	countryTests(t, *country)
+}
+
+func TestCountryUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body := CountryResource{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			expectedTax := decimal.NewFromFloat(0.05)
+			if body.Country == nil || body.Country.Tax == nil || !body.Country.Tax.Equal(expectedTax) {
+				t.Errorf("Country.Update sent %+v, expected tax %s", body.Country, expectedTax)
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("country.json")), nil
+		})
+
+	tax := decimal.NewFromFloat(0.05)
+	country, err := client.Country.Update(Country{ID: 879921427, Tax: &tax})
+	if err != nil {
+		t.Fatalf("Country.Update returned error: %v", err)
+	}
+
+	countryTests(t, *country)
+}
+
+func TestCountryDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Country.Delete(879921427)
+	if err != nil {
+		t.Errorf("Country.Delete returned error: %v", err)
+	}
+}
@@ -24,6 +24,8 @@ type CustomerService interface {
 	Delete(int64) error
 	ListOrders(int64, interface{}) ([]Order, error)
 	ListTags(interface{}) ([]string, error)
+	GetAccountActivationURL(int64) (string, error)
+	SendInvite(int64, CustomerInvite) (*CustomerInvite, error)
 
 	// MetafieldsService used for Customer resource to communicate with Metafields resource
 	MetafieldsService
@@ -58,6 +60,28 @@ type Customer struct {
 	CreatedAt           *time.Time         `json:"created_at,omitempty"`
 	UpdatedAt           *time.Time         `json:"updated_at,omitempty"`
 	Metafields          []Metafield        `json:"metafields,omitempty"`
+
+	// Password and PasswordConfirmation set the password of the customer
+	// account on create or update, SendEmailInvite sends an account invite
+	// on create
+	Password             string `json:"password,omitempty"`
+	PasswordConfirmation string `json:"password_confirmation,omitempty"`
+	SendEmailInvite      bool   `json:"send_email_invite,omitempty"`
+}
+
+// CustomerInvite is an invite to create a customer account. Fields left
+// empty default to the store's customer account invite template.
+type CustomerInvite struct {
+	To            string   `json:"to,omitempty"`
+	From          string   `json:"from,omitempty"`
+	Subject       string   `json:"subject,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty"`
+	Bcc           []string `json:"bcc,omitempty"`
+}
+
+// Represents the result from the customers/X/send_invite.json endpoint
+type CustomerInviteResource struct {
+	CustomerInvite *CustomerInvite `json:"customer_invite"`
 }
 
 // Represents the result from the customers/X.json endpoint
@@ -167,6 +191,26 @@ func (s *CustomerServiceOp) ListTags(options interface{}) ([]string, error) {
 	return resource.Tags, err
 }
 
+// GetAccountActivationURL creates a url that a customer can use to activate
+// their account, invalidating the previous one
+func (s *CustomerServiceOp) GetAccountActivationURL(customerID int64) (string, error) {
+	path := fmt.Sprintf("%s/%d/account_activation_url.json", customersBasePath, customerID)
+	resource := struct {
+		AccountActivationURL string `json:"account_activation_url"`
+	}{}
+	err := s.client.Post(path, nil, &resource)
+	return resource.AccountActivationURL, err
+}
+
+// SendInvite sends an account invite to a customer
+func (s *CustomerServiceOp) SendInvite(customerID int64, invite CustomerInvite) (*CustomerInvite, error) {
+	path := fmt.Sprintf("%s/%d/send_invite.json", customersBasePath, customerID)
+	wrappedData := CustomerInviteResource{CustomerInvite: &invite}
+	resource := new(CustomerInviteResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.CustomerInvite, err
+}
+
 // List metafields for a customer
 func (s *CustomerServiceOp) ListMetafields(customerID int64, options interface{}) ([]Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceID: customerID}
@@ -0,0 +1,140 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const customerSavedSearchesBasePath = "customer_saved_searches"
+
+// CustomerSavedSearchService is an interface for interfacing with the customer
+// saved search endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/customersavedsearch
+type CustomerSavedSearchService interface {
+	List(interface{}) ([]CustomerSavedSearch, error)
+	ListWithPagination(interface{}) ([]CustomerSavedSearch, *Pagination, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*CustomerSavedSearch, error)
+	Create(CustomerSavedSearch) (*CustomerSavedSearch, error)
+	Update(CustomerSavedSearch) (*CustomerSavedSearch, error)
+	Delete(int64) error
+	ListCustomers(int64, interface{}) ([]Customer, error)
+	ListCustomersWithPagination(int64, interface{}) ([]Customer, *Pagination, error)
+}
+
+// CustomerSavedSearchServiceOp handles communication with the customer saved
+// search related methods of the Shopify API.
+type CustomerSavedSearchServiceOp struct {
+	client *Client
+}
+
+// CustomerSavedSearch represents a Shopify customer saved search, a customer
+// search query used to segment customers
+type CustomerSavedSearch struct {
+	ID        int64      `json:"id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	Query     string     `json:"query,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// CustomerSavedSearchResource represents the result from the customer_saved_searches/X.json endpoint
+type CustomerSavedSearchResource struct {
+	CustomerSavedSearch *CustomerSavedSearch `json:"customer_saved_search"`
+}
+
+// CustomerSavedSearchesResource represents the result from the customer_saved_searches.json endpoint
+type CustomerSavedSearchesResource struct {
+	CustomerSavedSearches []CustomerSavedSearch `json:"customer_saved_searches"`
+}
+
+// A struct for the options of listing the customers of a saved search
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/customersavedsearch#get-customer-saved-searches-customer-saved-search-id-customers
+type CustomerSavedSearchCustomersOptions struct {
+	PageInfo string `url:"page_info,omitempty"`
+	Limit    int    `url:"limit,omitempty"`
+	Order    string `url:"order,omitempty"`
+	Fields   string `url:"fields,omitempty"`
+}
+
+// List customer saved searches
+func (s *CustomerSavedSearchServiceOp) List(options interface{}) ([]CustomerSavedSearch, error) {
+	searches, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return searches, nil
+}
+
+// ListWithPagination lists customer saved searches and return pagination to retrieve next/previous results.
+func (s *CustomerSavedSearchServiceOp) ListWithPagination(options interface{}) ([]CustomerSavedSearch, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", customerSavedSearchesBasePath)
+	resource := new(CustomerSavedSearchesResource)
+
+	pagination, err := s.client.ListWithPagination(path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.CustomerSavedSearches, pagination, nil
+}
+
+// Count customer saved searches
+func (s *CustomerSavedSearchServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", customerSavedSearchesBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual customer saved search
+func (s *CustomerSavedSearchServiceOp) Get(searchID int64, options interface{}) (*CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s/%d.json", customerSavedSearchesBasePath, searchID)
+	resource := new(CustomerSavedSearchResource)
+	err := s.client.Get(path, resource, options)
+	return resource.CustomerSavedSearch, err
+}
+
+// Create a new customer saved search
+func (s *CustomerSavedSearchServiceOp) Create(search CustomerSavedSearch) (*CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s.json", customerSavedSearchesBasePath)
+	wrappedData := CustomerSavedSearchResource{CustomerSavedSearch: &search}
+	resource := new(CustomerSavedSearchResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.CustomerSavedSearch, err
+}
+
+// Update an existing customer saved search
+func (s *CustomerSavedSearchServiceOp) Update(search CustomerSavedSearch) (*CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s/%d.json", customerSavedSearchesBasePath, search.ID)
+	wrappedData := CustomerSavedSearchResource{CustomerSavedSearch: &search}
+	resource := new(CustomerSavedSearchResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.CustomerSavedSearch, err
+}
+
+// Delete an existing customer saved search
+func (s *CustomerSavedSearchServiceOp) Delete(searchID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", customerSavedSearchesBasePath, searchID))
+}
+
+// ListCustomers lists the customers matching a saved search
+func (s *CustomerSavedSearchServiceOp) ListCustomers(searchID int64, options interface{}) ([]Customer, error) {
+	customers, _, err := s.ListCustomersWithPagination(searchID, options)
+	if err != nil {
+		return nil, err
+	}
+	return customers, nil
+}
+
+// ListCustomersWithPagination lists the customers matching a saved search and
+// return pagination to retrieve next/previous results.
+func (s *CustomerSavedSearchServiceOp) ListCustomersWithPagination(searchID int64, options interface{}) ([]Customer, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/customers.json", customerSavedSearchesBasePath, searchID)
+	resource := new(CustomersResource)
+
+	pagination, err := s.client.ListWithPagination(path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Customers, pagination, nil
+}
@@ -0,0 +1,174 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func customerSavedSearchTests(t *testing.T, search CustomerSavedSearch) {
+	expectedID := int64(789629109)
+	if search.ID != expectedID {
+		t.Errorf("CustomerSavedSearch.ID returned %+v, expected %+v", search.ID, expectedID)
+	}
+
+	expectedName := "Accepts Marketing"
+	if search.Name != expectedName {
+		t.Errorf("CustomerSavedSearch.Name returned %+v, expected %+v", search.Name, expectedName)
+	}
+
+	expectedQuery := "email_subscription_status:subscribed"
+	if search.Query != expectedQuery {
+		t.Errorf("CustomerSavedSearch.Query returned %+v, expected %+v", search.Query, expectedQuery)
+	}
+
+	expectedCreatedAt := time.Date(2023, time.October, 3, 17, 22, 46, 0, time.UTC)
+	if search.CreatedAt == nil || !expectedCreatedAt.Equal(*search.CreatedAt) {
+		t.Errorf("CustomerSavedSearch.CreatedAt returned %+v, expected %+v", search.CreatedAt, expectedCreatedAt)
+	}
+}
+
+func TestCustomerSavedSearchList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_saved_searches.json")))
+
+	searches, err := client.CustomerSavedSearch.List(nil)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.List returned error: %v", err)
+	}
+
+	if len(searches) != 2 {
+		t.Fatalf("CustomerSavedSearch.List returned %d searches, expected 2", len(searches))
+	}
+	customerSavedSearchTests(t, searches[0])
+}
+
+func TestCustomerSavedSearchCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.CustomerSavedSearch.Count(nil)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("CustomerSavedSearch.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCustomerSavedSearchGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/789629109.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_saved_search.json")))
+
+	search, err := client.CustomerSavedSearch.Get(789629109, nil)
+	if err != nil {
+		t.Fatalf("CustomerSavedSearch.Get returned error: %v", err)
+	}
+
+	customerSavedSearchTests(t, *search)
+}
+
+func TestCustomerSavedSearchCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("customer_saved_search.json")))
+
+	search, err := client.CustomerSavedSearch.Create(CustomerSavedSearch{
+		Name:  "Accepts Marketing",
+		Query: "email_subscription_status:subscribed",
+	})
+	if err != nil {
+		t.Fatalf("CustomerSavedSearch.Create returned error: %v", err)
+	}
+
+	customerSavedSearchTests(t, *search)
+}
+
+func TestCustomerSavedSearchUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/789629109.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_saved_search.json")))
+
+	search, err := client.CustomerSavedSearch.Update(CustomerSavedSearch{ID: 789629109, Name: "Accepts Marketing"})
+	if err != nil {
+		t.Fatalf("CustomerSavedSearch.Update returned error: %v", err)
+	}
+
+	customerSavedSearchTests(t, *search)
+}
+
+func TestCustomerSavedSearchDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/789629109.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CustomerSavedSearch.Delete(789629109)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.Delete returned error: %v", err)
+	}
+}
+
+func TestCustomerSavedSearchListCustomers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/789629109/customers.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, httpmock.ResponderFromResponse(&http.Response{
+		StatusCode: 200,
+		Body:       httpmock.NewRespBodyFromString(`{"customers": [{"id":1},{"id":2}]}`),
+		Header: http.Header{
+			"Link": {`<http://valid.url?page_info=pageInfoCode&limit=2>; rel="next"`},
+		},
+	}))
+
+	params := map[string]string{"page_info": "pageInfoCode", "limit": "2"}
+	httpmock.RegisterResponderWithQuery("GET", listURL, params,
+		httpmock.NewStringResponder(200, `{"customers": [{"id":3}]}`))
+
+	customers, pagination, err := client.CustomerSavedSearch.ListCustomersWithPagination(789629109, CustomerSavedSearchCustomersOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("CustomerSavedSearch.ListCustomersWithPagination returned error: %v", err)
+	}
+
+	expected := []Customer{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(customers, expected) {
+		t.Errorf("CustomerSavedSearch.ListCustomersWithPagination returned %+v, expected %+v", customers, expected)
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pageInfoCode", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Fatalf("CustomerSavedSearch.ListCustomersWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+
+	customers, err = client.CustomerSavedSearch.ListCustomers(789629109, pagination.NextPageOptions)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.ListCustomers returned error: %v", err)
+	}
+
+	expected = []Customer{{ID: 3}}
+	if !reflect.DeepEqual(customers, expected) {
+		t.Errorf("CustomerSavedSearch.ListCustomers returned %+v, expected %+v", customers, expected)
+	}
+}
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -608,3 +609,77 @@ func TestCustomerListTags(t *testing.T) {
 		t.Errorf("Customer.ListTags got %v as the first tag, expected: 'tag1'", tags[0])
 	}
 }
+
+func TestCustomerCreateWithPassword(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requested CustomerResource
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&requested); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("customer.json")), nil
+		})
+
+	customer := Customer{
+		Email:                "steve.lastnameson@example.com",
+		Password:             "newpass",
+		PasswordConfirmation: "newpass",
+		SendEmailInvite:      true,
+	}
+
+	_, err := client.Customer.Create(customer)
+	if err != nil {
+		t.Errorf("Customer.Create returned error: %v", err)
+	}
+
+	if requested.Customer == nil || requested.Customer.Password != "newpass" ||
+		requested.Customer.PasswordConfirmation != "newpass" || !requested.Customer.SendEmailInvite {
+		t.Errorf("Customer.Create sent %+v, expected the password and invite", requested.Customer)
+	}
+}
+
+func TestCustomerGetAccountActivationURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/account_activation_url.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"account_activation_url": "https://fooshop.myshopify.com/account/activate/1/e0b1c7b9d1c1d0f4"}`))
+
+	activationURL, err := client.Customer.GetAccountActivationURL(1)
+	if err != nil {
+		t.Errorf("Customer.GetAccountActivationURL returned error: %v", err)
+	}
+
+	expected := "https://fooshop.myshopify.com/account/activate/1/e0b1c7b9d1c1d0f4"
+	if activationURL != expected {
+		t.Errorf("Customer.GetAccountActivationURL returned %s, expected %s", activationURL, expected)
+	}
+}
+
+func TestCustomerSendInvite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/send_invite.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("customer_invite.json")))
+
+	invite := CustomerInvite{
+		To:            "new_test_email@shopify.com",
+		From:          "j.limited@example.com",
+		Subject:       "Welcome to my new shop",
+		CustomMessage: "My awesome new store",
+		Bcc:           []string{"j.limited@example.com"},
+	}
+
+	returnedInvite, err := client.Customer.SendInvite(1, invite)
+	if err != nil {
+		t.Errorf("Customer.SendInvite returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(returnedInvite, &invite) {
+		t.Errorf("Customer.SendInvite returned %+v, expected %+v", returnedInvite, invite)
+	}
+}
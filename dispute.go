@@ -0,0 +1,244 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const disputesBasePath = "shopify_payments/disputes"
+
+// DisputeService is an interface for interfacing with the Shopify Payments
+// dispute endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/dispute
+type DisputeService interface {
+	List(interface{}) ([]Dispute, error)
+	ListWithPagination(interface{}) ([]Dispute, *Pagination, error)
+	Get(int64, interface{}) (*Dispute, error)
+	GetEvidence(int64) (*DisputeEvidence, error)
+	UpdateEvidence(int64, DisputeEvidence) (*DisputeEvidence, error)
+	UploadFile(int64, DisputeFileUploadRequest) (*DisputeFileUpload, error)
+	DeleteFile(int64, int64) error
+}
+
+// DisputeServiceOp handles communication with the dispute related methods of
+// the Shopify API.
+type DisputeServiceOp struct {
+	client *Client
+}
+
+// A struct for all available dispute list options
+type DisputeListOptions struct {
+	PageInfo    string        `url:"page_info,omitempty"`
+	Limit       int           `url:"limit,omitempty"`
+	SinceID     int64         `url:"since_id,omitempty"`
+	LastID      int64         `url:"last_id,omitempty"`
+	Status      DisputeStatus `url:"status,omitempty"`
+	InitiatedAt *OnlyDate     `url:"initiated_at,omitempty"`
+}
+
+// Dispute represents a Shopify Payments dispute, either a chargeback or an
+// inquiry raised by the card issuer
+type Dispute struct {
+	ID                int64            `json:"id,omitempty"`
+	OrderID           *int64           `json:"order_id,omitempty"`
+	Type              DisputeType      `json:"type,omitempty"`
+	Amount            *decimal.Decimal `json:"amount,omitempty"`
+	Currency          string           `json:"currency,omitempty"`
+	Reason            string           `json:"reason,omitempty"`
+	NetworkReasonCode string           `json:"network_reason_code,omitempty"`
+	Status            DisputeStatus    `json:"status,omitempty"`
+	EvidenceDueBy     *time.Time       `json:"evidence_due_by,omitempty"`
+	EvidenceSentOn    *time.Time       `json:"evidence_sent_on,omitempty"`
+	FinalizedOn       *time.Time       `json:"finalized_on,omitempty"`
+	InitiatedAt       *time.Time       `json:"initiated_at,omitempty"`
+}
+
+type DisputeType string
+
+const (
+	DisputeTypeChargeback DisputeType = "chargeback"
+	DisputeTypeInquiry    DisputeType = "inquiry"
+)
+
+type DisputeStatus string
+
+const (
+	DisputeStatusNeedsResponse  DisputeStatus = "needs_response"
+	DisputeStatusUnderReview    DisputeStatus = "under_review"
+	DisputeStatusChargeRefunded DisputeStatus = "charge_refunded"
+	DisputeStatusAccepted       DisputeStatus = "accepted"
+	DisputeStatusWon            DisputeStatus = "won"
+	DisputeStatusLost           DisputeStatus = "lost"
+)
+
+// DisputeEvidence represents the evidence submitted for a dispute. Set
+// SubmitEvidence when updating to submit the evidence to the card issuer,
+// otherwise it is saved as a draft.
+type DisputeEvidence struct {
+	ID                           int64                 `json:"id,omitempty"`
+	PaymentsDisputeID            int64                 `json:"payments_dispute_id,omitempty"`
+	AccessActivityLog            string                `json:"access_activity_log,omitempty"`
+	CancellationPolicyDisclosure string                `json:"cancellation_policy_disclosure,omitempty"`
+	CancellationRebuttal         string                `json:"cancellation_rebuttal,omitempty"`
+	RefundPolicyDisclosure       string                `json:"refund_policy_disclosure,omitempty"`
+	RefundRefusalExplanation     string                `json:"refund_refusal_explanation,omitempty"`
+	UncategorizedText            string                `json:"uncategorized_text,omitempty"`
+	CustomerEmailAddress         string                `json:"customer_email_address,omitempty"`
+	CustomerFirstName            string                `json:"customer_first_name,omitempty"`
+	CustomerLastName             string                `json:"customer_last_name,omitempty"`
+	BillingAddress               *Address              `json:"billing_address,omitempty"`
+	ShippingAddress              *Address              `json:"shipping_address,omitempty"`
+	ProductDescription           []DisputeProduct      `json:"product_description,omitempty"`
+	Fulfillments                 []DisputeFulfillment  `json:"fulfillments,omitempty"`
+	DisputeEvidenceFiles         *DisputeEvidenceFiles `json:"dispute_evidence_files,omitempty"`
+	SubmitEvidence               bool                  `json:"submit_evidence,omitempty"`
+	SubmittedByMerchantOn        *time.Time            `json:"submitted_by_merchant_on,omitempty"`
+	CreatedAt                    *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt                    *time.Time            `json:"updated_at,omitempty"`
+}
+
+// DisputeProduct describes a product of the disputed order
+type DisputeProduct struct {
+	ProductID   int64            `json:"product_id,omitempty"`
+	Title       string           `json:"title,omitempty"`
+	Price       *decimal.Decimal `json:"price,omitempty"`
+	Quantity    int              `json:"quantity,omitempty"`
+	SKU         string           `json:"sku,omitempty"`
+	Description string           `json:"description,omitempty"`
+}
+
+// DisputeFulfillment describes a shipment of the disputed order
+type DisputeFulfillment struct {
+	ShippingCarrier        string    `json:"shipping_carrier,omitempty"`
+	ShippingTrackingNumber string    `json:"shipping_tracking_number,omitempty"`
+	ShippingDate           *OnlyDate `json:"shipping_date,omitempty"`
+}
+
+// DisputeEvidenceFiles holds the IDs of the files uploaded as evidence, by
+// evidence type
+type DisputeEvidenceFiles struct {
+	CancellationPolicyFileID    *int64 `json:"cancellation_policy_file_id,omitempty"`
+	CustomerCommunicationFileID *int64 `json:"customer_communication_file_id,omitempty"`
+	CustomerSignatureFileID     *int64 `json:"customer_signature_file_id,omitempty"`
+	RefundPolicyFileID          *int64 `json:"refund_policy_file_id,omitempty"`
+	ServiceDocumentationFileID  *int64 `json:"service_documentation_file_id,omitempty"`
+	ShippingDocumentationFileID *int64 `json:"shipping_documentation_file_id,omitempty"`
+	UncategorizedFileID         *int64 `json:"uncategorized_file_id,omitempty"`
+}
+
+type DisputeEvidenceType string
+
+// Use DisputeEvidenceTypeShippingDocumentation for proof of fulfillment and
+// DisputeEvidenceTypeCustomerSignature for signed receipts.
+const (
+	DisputeEvidenceTypeCancellationPolicy    DisputeEvidenceType = "cancellation_policy_file"
+	DisputeEvidenceTypeCustomerCommunication DisputeEvidenceType = "customer_communication_file"
+	DisputeEvidenceTypeCustomerSignature     DisputeEvidenceType = "customer_signature_file"
+	DisputeEvidenceTypeRefundPolicy          DisputeEvidenceType = "refund_policy_file"
+	DisputeEvidenceTypeServiceDocumentation  DisputeEvidenceType = "service_documentation_file"
+	DisputeEvidenceTypeShippingDocumentation DisputeEvidenceType = "shipping_documentation_file"
+	DisputeEvidenceTypeUncategorized         DisputeEvidenceType = "uncategorized_file"
+)
+
+// DisputeFileUploadRequest is a file to upload as dispute evidence. Data is
+// the base64 encoded file content.
+type DisputeFileUploadRequest struct {
+	DocumentType DisputeEvidenceType `json:"document_type"`
+	Filename     string              `json:"filename"`
+	Mimetype     string              `json:"mimetype"`
+	Data         string              `json:"data"`
+}
+
+// DisputeFileUpload is a file uploaded as dispute evidence
+type DisputeFileUpload struct {
+	ID                  int64               `json:"id,omitempty"`
+	ShopID              int64               `json:"shop_id,omitempty"`
+	DisputeEvidenceID   int64               `json:"dispute_evidence_id,omitempty"`
+	DisputeEvidenceType DisputeEvidenceType `json:"dispute_evidence_type,omitempty"`
+	OriginalFilename    string              `json:"original_filename,omitempty"`
+	FileType            string              `json:"file_type,omitempty"`
+	FileSize            int64               `json:"file_size,omitempty"`
+	URL                 string              `json:"url,omitempty"`
+}
+
+// DisputeResource represents the result from the disputes/X.json endpoint
+type DisputeResource struct {
+	Dispute *Dispute `json:"dispute"`
+}
+
+// DisputesResource represents the result from the disputes.json endpoint
+type DisputesResource struct {
+	Disputes []Dispute `json:"disputes"`
+}
+
+// DisputeEvidenceResource represents the result from the disputes/X/dispute_evidences.json endpoint
+type DisputeEvidenceResource struct {
+	DisputeEvidence *DisputeEvidence `json:"dispute_evidence"`
+}
+
+// DisputeFileUploadResource represents the result from the disputes/X/dispute_file_uploads.json endpoint
+type DisputeFileUploadResource struct {
+	DisputeFileUpload *DisputeFileUpload `json:"dispute_file_upload"`
+}
+
+// List disputes
+func (s *DisputeServiceOp) List(options interface{}) ([]Dispute, error) {
+	disputes, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return disputes, nil
+}
+
+// ListWithPagination lists disputes and return pagination to retrieve next/previous results.
+func (s *DisputeServiceOp) ListWithPagination(options interface{}) ([]Dispute, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", disputesBasePath)
+	resource := new(DisputesResource)
+
+	pagination, err := s.client.ListWithPagination(path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Disputes, pagination, nil
+}
+
+// Get individual dispute
+func (s *DisputeServiceOp) Get(disputeID int64, options interface{}) (*Dispute, error) {
+	path := fmt.Sprintf("%s/%d.json", disputesBasePath, disputeID)
+	resource := new(DisputeResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Dispute, err
+}
+
+// GetEvidence gets the evidence of a dispute
+func (s *DisputeServiceOp) GetEvidence(disputeID int64) (*DisputeEvidence, error) {
+	path := fmt.Sprintf("%s/%d/dispute_evidences.json", disputesBasePath, disputeID)
+	resource := new(DisputeEvidenceResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.DisputeEvidence, err
+}
+
+// UpdateEvidence updates the evidence of a dispute
+func (s *DisputeServiceOp) UpdateEvidence(disputeID int64, evidence DisputeEvidence) (*DisputeEvidence, error) {
+	path := fmt.Sprintf("%s/%d/dispute_evidences.json", disputesBasePath, disputeID)
+	wrappedData := DisputeEvidenceResource{DisputeEvidence: &evidence}
+	resource := new(DisputeEvidenceResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.DisputeEvidence, err
+}
+
+// UploadFile uploads a file as evidence for a dispute
+func (s *DisputeServiceOp) UploadFile(disputeID int64, upload DisputeFileUploadRequest) (*DisputeFileUpload, error) {
+	path := fmt.Sprintf("%s/%d/dispute_file_uploads.json", disputesBasePath, disputeID)
+	resource := new(DisputeFileUploadResource)
+	err := s.client.Post(path, upload, resource)
+	return resource.DisputeFileUpload, err
+}
+
+// DeleteFile deletes a file uploaded as evidence for a dispute
+func (s *DisputeServiceOp) DeleteFile(disputeID int64, fileID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d/dispute_file_uploads/%d.json", disputesBasePath, disputeID, fileID))
+}
@@ -0,0 +1,253 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func disputeTests(t *testing.T, dispute Dispute) {
+	expectedID := int64(598735659)
+	if dispute.ID != expectedID {
+		t.Errorf("Dispute.ID returned %+v, expected %+v", dispute.ID, expectedID)
+	}
+
+	expectedOrderID := int64(625362839)
+	if dispute.OrderID == nil || *dispute.OrderID != expectedOrderID {
+		t.Errorf("Dispute.OrderID returned %+v, expected %+v", dispute.OrderID, expectedOrderID)
+	}
+
+	if dispute.Type != DisputeTypeChargeback {
+		t.Errorf("Dispute.Type returned %+v, expected %+v", dispute.Type, DisputeTypeChargeback)
+	}
+
+	if dispute.Status != DisputeStatusNeedsResponse {
+		t.Errorf("Dispute.Status returned %+v, expected %+v", dispute.Status, DisputeStatusNeedsResponse)
+	}
+
+	expectedAmount := decimal.NewFromFloat(11.50)
+	if dispute.Amount == nil || !dispute.Amount.Equal(expectedAmount) {
+		t.Errorf("Dispute.Amount returned %+v, expected %+v", dispute.Amount, expectedAmount)
+	}
+
+	expectedEvidenceDueBy := time.Date(2023, time.October, 10, 15, 0, 0, 0, time.UTC)
+	if dispute.EvidenceDueBy == nil || !expectedEvidenceDueBy.Equal(*dispute.EvidenceDueBy) {
+		t.Errorf("Dispute.EvidenceDueBy returned %+v, expected %+v", dispute.EvidenceDueBy, expectedEvidenceDueBy)
+	}
+
+	if dispute.FinalizedOn != nil {
+		t.Errorf("Dispute.FinalizedOn returned %+v, expected nil", dispute.FinalizedOn)
+	}
+}
+
+func TestDisputeList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("disputes.json")))
+
+	params := map[string]string{"status": "won", "initiated_at": `"2013-05-03"`}
+	httpmock.RegisterResponderWithQuery("GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"disputes": [{"id":85190714,"status":"won"}]}`))
+
+	disputes, err := client.Dispute.List(nil)
+	if err != nil {
+		t.Errorf("Dispute.List returned error: %v", err)
+	}
+	if len(disputes) != 2 {
+		t.Fatalf("Dispute.List returned %d disputes, expected 2", len(disputes))
+	}
+	disputeTests(t, disputes[0])
+
+	initiatedAt := OnlyDate{time.Date(2013, time.May, 3, 0, 0, 0, 0, time.UTC)}
+	disputes, err = client.Dispute.List(DisputeListOptions{Status: DisputeStatusWon, InitiatedAt: &initiatedAt})
+	if err != nil {
+		t.Errorf("Dispute.List returned error: %v", err)
+	}
+
+	expected := []Dispute{{ID: 85190714, Status: DisputeStatusWon}}
+	if !reflect.DeepEqual(disputes, expected) {
+		t.Errorf("Dispute.List returned %+v, expected %+v", disputes, expected)
+	}
+}
+
+func TestDisputeListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, httpmock.ResponderFromResponse(&http.Response{
+		StatusCode: 200,
+		Body:       httpmock.NewRespBodyFromString(`{"disputes": [{"id":1},{"id":2}]}`),
+		Header: http.Header{
+			"Link": {`<http://valid.url?page_info=pageInfoCode&limit=2>; rel="next"`},
+		},
+	}))
+
+	disputes, pagination, err := client.Dispute.ListWithPagination(DisputeListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Dispute.ListWithPagination returned error: %v", err)
+	}
+
+	expected := []Dispute{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(disputes, expected) {
+		t.Errorf("Dispute.ListWithPagination returned %+v, expected %+v", disputes, expected)
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pageInfoCode", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Dispute.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestDisputeGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("dispute.json")))
+
+	dispute, err := client.Dispute.Get(598735659, nil)
+	if err != nil {
+		t.Fatalf("Dispute.Get returned error: %v", err)
+	}
+
+	disputeTests(t, *dispute)
+}
+
+func TestDisputeGetEvidence(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_evidences.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("dispute_evidence.json")))
+
+	evidence, err := client.Dispute.GetEvidence(598735659)
+	if err != nil {
+		t.Fatalf("Dispute.GetEvidence returned error: %v", err)
+	}
+
+	if evidence.PaymentsDisputeID != 598735659 {
+		t.Errorf("DisputeEvidence.PaymentsDisputeID returned %+v, expected %+v", evidence.PaymentsDisputeID, 598735659)
+	}
+
+	if evidence.BillingAddress == nil || evidence.BillingAddress.City != "Cupertino" {
+		t.Errorf("DisputeEvidence.BillingAddress returned %+v, expected city Cupertino", evidence.BillingAddress)
+	}
+
+	expectedPrice := decimal.NewFromFloat(199)
+	if len(evidence.ProductDescription) != 1 || evidence.ProductDescription[0].Price == nil || !evidence.ProductDescription[0].Price.Equal(expectedPrice) {
+		t.Errorf("DisputeEvidence.ProductDescription returned %+v, expected price %s", evidence.ProductDescription, expectedPrice)
+	}
+
+	expectedShippingDate := time.Date(2023, time.September, 27, 0, 0, 0, 0, time.UTC)
+	if len(evidence.Fulfillments) != 1 || evidence.Fulfillments[0].ShippingDate == nil || !evidence.Fulfillments[0].ShippingDate.Equal(expectedShippingDate) {
+		t.Errorf("DisputeEvidence.Fulfillments returned %+v, expected shipping date %s", evidence.Fulfillments, expectedShippingDate)
+	}
+
+	files := evidence.DisputeEvidenceFiles
+	if files == nil || files.CustomerCommunicationFileID == nil || *files.CustomerCommunicationFileID != 539650252 || files.UncategorizedFileID != nil {
+		t.Errorf("DisputeEvidence.DisputeEvidenceFiles returned %+v, expected customer communication file 539650252", files)
+	}
+}
+
+func TestDisputeUpdateEvidence(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_evidences.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]map[string]interface{}{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			evidence := body["dispute_evidence"]
+			if evidence["submit_evidence"] != true || evidence["uncategorized_text"] != "Sample uncategorized text" {
+				t.Errorf("Dispute.UpdateEvidence sent %+v, expected submitted evidence", evidence)
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("dispute_evidence.json")), nil
+		})
+
+	evidence, err := client.Dispute.UpdateEvidence(598735659, DisputeEvidence{
+		UncategorizedText: "Sample uncategorized text",
+		SubmitEvidence:    true,
+	})
+	if err != nil {
+		t.Fatalf("Dispute.UpdateEvidence returned error: %v", err)
+	}
+
+	expectedID := int64(819974671)
+	if evidence.ID != expectedID {
+		t.Errorf("DisputeEvidence.ID returned %+v, expected %+v", evidence.ID, expectedID)
+	}
+}
+
+func TestDisputeUploadFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_file_uploads.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]interface{}{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			expectedBody := map[string]interface{}{
+				"document_type": "customer_communication_file",
+				"filename":      "customer_emails.txt",
+				"mimetype":      "text/plain",
+				"data":          "aGVsbG8gd29ybGQ=",
+			}
+			if !reflect.DeepEqual(body, expectedBody) {
+				t.Errorf("Dispute.UploadFile sent %+v, expected %+v", body, expectedBody)
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("dispute_file_upload.json")), nil
+		})
+
+	upload, err := client.Dispute.UploadFile(598735659, DisputeFileUploadRequest{
+		DocumentType: DisputeEvidenceTypeCustomerCommunication,
+		Filename:     "customer_emails.txt",
+		Mimetype:     "text/plain",
+		Data:         "aGVsbG8gd29ybGQ=",
+	})
+	if err != nil {
+		t.Fatalf("Dispute.UploadFile returned error: %v", err)
+	}
+
+	expected := &DisputeFileUpload{
+		ID:                  539650252,
+		ShopID:              548380009,
+		DisputeEvidenceID:   819974671,
+		DisputeEvidenceType: DisputeEvidenceTypeCustomerCommunication,
+		FileSize:            11,
+		FileType:            "text/plain",
+		OriginalFilename:    "customer_emails.txt",
+		URL:                 "https://cdn.shopify.com/dispute_file_uploads/539650252/customer_emails.txt",
+	}
+	if !reflect.DeepEqual(upload, expected) {
+		t.Errorf("Dispute.UploadFile returned %+v, expected %+v", upload, expected)
+	}
+}
+
+func TestDisputeDeleteFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_file_uploads/539650252.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Dispute.DeleteFile(598735659, 539650252)
+	if err != nil {
+		t.Errorf("Dispute.DeleteFile returned error: %v", err)
+	}
+}
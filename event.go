@@ -0,0 +1,107 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const eventsBasePath = "events"
+
+// EventService is an interface for interfacing with the event endpoints of
+// the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/event
+type EventService interface {
+	List(interface{}) ([]Event, error)
+	ListWithPagination(interface{}) ([]Event, *Pagination, error)
+	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Event, error)
+}
+
+// EventsService is an interface for other Shopify resources
+// to interface with the event endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/event
+type EventsService interface {
+	ListEvents(int64, interface{}) ([]Event, error)
+	ListEventsWithPagination(int64, interface{}) ([]Event, *Pagination, error)
+}
+
+// EventServiceOp handles communication with the event related methods of the
+// Shopify API.
+type EventServiceOp struct {
+	client     *Client
+	resource   string
+	resourceID int64
+}
+
+// Event represents a Shopify event, an action on a resource of the store
+type Event struct {
+	ID          int64       `json:"id,omitempty"`
+	SubjectID   int64       `json:"subject_id,omitempty"`
+	SubjectType string      `json:"subject_type,omitempty"`
+	Verb        string      `json:"verb,omitempty"`
+	Arguments   interface{} `json:"arguments,omitempty"`
+	Body        string      `json:"body,omitempty"`
+	Message     string      `json:"message,omitempty"`
+	Author      string      `json:"author,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Path        string      `json:"path,omitempty"`
+	CreatedAt   *time.Time  `json:"created_at,omitempty"`
+}
+
+// EventResource represents the result from the events/X.json endpoint
+type EventResource struct {
+	Event *Event `json:"event"`
+}
+
+// EventsResource represents the result from the events.json endpoint
+type EventsResource struct {
+	Events []Event `json:"events"`
+}
+
+// A struct for all available event list options.
+// Filter is a comma separated list of subject types, e.g. "Product,Order",
+// and Verb is the action, e.g. "create" or "destroy".
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/event#get-events
+type EventListOptions struct {
+	ListOptions
+	Filter string `url:"filter,omitempty"`
+	Verb   string `url:"verb,omitempty"`
+}
+
+// List events
+func (s *EventServiceOp) List(options interface{}) ([]Event, error) {
+	events, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// ListWithPagination lists events and return pagination to retrieve next/previous results.
+func (s *EventServiceOp) ListWithPagination(options interface{}) ([]Event, *Pagination, error) {
+	prefix := EventPathPrefix(s.resource, s.resourceID)
+	path := fmt.Sprintf("%s.json", prefix)
+	resource := new(EventsResource)
+
+	pagination, err := s.client.ListWithPagination(path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Events, pagination, nil
+}
+
+// Count events
+func (s *EventServiceOp) Count(options interface{}) (int, error) {
+	prefix := EventPathPrefix(s.resource, s.resourceID)
+	path := fmt.Sprintf("%s/count.json", prefix)
+	return s.client.Count(path, options)
+}
+
+// Get individual event
+func (s *EventServiceOp) Get(eventID int64, options interface{}) (*Event, error) {
+	path := fmt.Sprintf("%s/%d.json", eventsBasePath, eventID)
+	resource := new(EventResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Event, err
+}
@@ -0,0 +1,157 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func eventTests(t *testing.T, event Event) {
+	expectedID := int64(677313116)
+	if event.ID != expectedID {
+		t.Errorf("Event.ID returned %+v, expected %+v", event.ID, expectedID)
+	}
+
+	expectedSubjectID := int64(921728736)
+	if event.SubjectID != expectedSubjectID {
+		t.Errorf("Event.SubjectID returned %+v, expected %+v", event.SubjectID, expectedSubjectID)
+	}
+
+	if event.SubjectType != "Product" || event.Verb != "create" {
+		t.Errorf("Event returned subject type %s and verb %s, expected Product and create", event.SubjectType, event.Verb)
+	}
+
+	expectedArguments := []interface{}{"IPod Touch 8GB"}
+	if !reflect.DeepEqual(event.Arguments, expectedArguments) {
+		t.Errorf("Event.Arguments returned %+v, expected %+v", event.Arguments, expectedArguments)
+	}
+
+	expectedCreatedAt := time.Date(2008, time.January, 10, 13, 0, 0, 0, time.UTC)
+	if event.CreatedAt == nil || !expectedCreatedAt.Equal(*event.CreatedAt) {
+		t.Errorf("Event.CreatedAt returned %+v, expected %+v", event.CreatedAt, expectedCreatedAt)
+	}
+
+	expectedPath := "/admin/products/921728736"
+	if event.Path != expectedPath {
+		t.Errorf("Event.Path returned %+v, expected %+v", event.Path, expectedPath)
+	}
+}
+
+func TestEventList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/events.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("events.json")))
+
+	params := map[string]string{"filter": "Product,Order", "verb": "destroy", "created_at_min": "2008-01-01T00:00:00Z"}
+	httpmock.RegisterResponderWithQuery("GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/events.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"events": [{"id":164748010,"verb":"destroy"}]}`))
+
+	events, err := client.Event.List(nil)
+	if err != nil {
+		t.Errorf("Event.List returned error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Errorf("Event.List returned %d events, expected 2", len(events))
+	}
+
+	options := EventListOptions{
+		ListOptions: ListOptions{CreatedAtMin: time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		Filter:      "Product,Order",
+		Verb:        "destroy",
+	}
+	events, err = client.Event.List(options)
+	if err != nil {
+		t.Errorf("Event.List returned error: %v", err)
+	}
+
+	expected := []Event{{ID: 164748010, Verb: "destroy"}}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Event.List returned %+v, expected %+v", events, expected)
+	}
+}
+
+func TestEventListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/events.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, httpmock.ResponderFromResponse(&http.Response{
+		StatusCode: 200,
+		Body:       httpmock.NewRespBodyFromBytes(loadFixture("events.json")),
+		Header: http.Header{
+			"Link": {`<http://valid.url?page_info=pageInfoCode&limit=2>; rel="next"`},
+		},
+	}))
+
+	events, pagination, err := client.Event.ListWithPagination(EventListOptions{ListOptions: ListOptions{Limit: 2}})
+	if err != nil {
+		t.Fatalf("Event.ListWithPagination returned error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Errorf("Event.ListWithPagination returned %d events, expected 2", len(events))
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pageInfoCode", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Event.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestEventCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/events/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	params := map[string]string{"created_at_min": "2016-01-01T00:00:00Z"}
+	httpmock.RegisterResponderWithQuery("GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/events/count.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.Event.Count(nil)
+	if err != nil {
+		t.Errorf("Event.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("Event.Count returned %d, expected %d", cnt, expected)
+	}
+
+	date := time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC)
+	cnt, err = client.Event.Count(CountOptions{CreatedAtMin: date})
+	if err != nil {
+		t.Errorf("Event.Count returned error: %v", err)
+	}
+
+	expected = 2
+	if cnt != expected {
+		t.Errorf("Event.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestEventGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/events/677313116.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("event.json")))
+
+	event, err := client.Event.Get(677313116, nil)
+	if err != nil {
+		t.Fatalf("Event.Get returned error: %v", err)
+	}
+
+	eventTests(t, *event)
+}
@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -19,6 +20,9 @@ type FulfillmentOrderService interface {
 	Reschedule(int64) (*FulfillmentOrder, error)
 	SetDeadline([]int64, time.Time) error
 	Move(int64, FulfillmentOrderMoveRequest) (*FulfillmentOrderMoveResource, error)
+	LocationsForMove(int64) ([]FulfillmentOrderLocationForMove, error)
+	Split(int64, []FulfillmentOrderLineItemQuantity) (*FulfillmentOrderSplitResult, error)
+	Merge([]FulfillmentOrderMergeIntent) (*FulfillmentOrder, error)
 }
 
 // FulfillmentOrderHoldReason represents the reason for a fulfillment hold
@@ -157,6 +161,41 @@ type FulfillmentOrderMoveResource struct {
 	MovedFulfillmentOrder    FulfillmentOrder `json:"moved_fulfillment_order"`
 }
 
+// FulfillmentOrderLocationForMove is a location a fulfillment order can be
+// moved to. Message explains why the location is not Movable.
+type FulfillmentOrderLocationForMove struct {
+	Location Location `json:"location"`
+	Message  string   `json:"message,omitempty"`
+	Movable  bool     `json:"movable"`
+}
+
+// FulfillmentOrderLocationsForMoveResource represents the result from the locations_for_move.json endpoint
+type FulfillmentOrderLocationsForMoveResource struct {
+	LocationsForMove []FulfillmentOrderLocationForMove `json:"locations_for_move"`
+}
+
+// FulfillmentOrderSplitResult is the result of splitting a fulfillment order.
+// FulfillmentOrder keeps the quantities that were not split off,
+// RemainingFulfillmentOrder has the split quantities and
+// ReplacementFulfillmentOrder, if any, replaces the original fulfillment
+// order.
+//
+// The fulfillment orders are read from the GraphQL Admin API, only their Id,
+// OrderId, AssignedLocationId, Status, RequestStatus and line items are set.
+type FulfillmentOrderSplitResult struct {
+	FulfillmentOrder            *FulfillmentOrder
+	RemainingFulfillmentOrder   *FulfillmentOrder
+	ReplacementFulfillmentOrder *FulfillmentOrder
+}
+
+// FulfillmentOrderMergeIntent is a fulfillment order to merge, with the
+// quantities of its line items to merge. All of its line items are merged if
+// LineItems is empty.
+type FulfillmentOrderMergeIntent struct {
+	FulfillmentOrderId int64
+	LineItems          []FulfillmentOrderLineItemQuantity
+}
+
 // FulfillmentOrderPathPrefix returns the prefix for a fulfillmentOrder path
 func FulfillmentOrderPathPrefix(resource string, resourceID int64) string {
 	return fmt.Sprintf("%s/%d", resource, resourceID)
@@ -281,3 +320,273 @@ func (s *FulfillmentOrderServiceOp) Move(fulfillmentID int64, moveRequest Fulfil
 	err := s.client.Post(path, wrappedRequest, resource)
 	return resource, err
 }
+
+// LocationsForMove lists the locations a fulfillment order can be moved to
+func (s *FulfillmentOrderServiceOp) LocationsForMove(fulfillmentID int64) ([]FulfillmentOrderLocationForMove, error) {
+	prefix := FulfillmentOrderPathPrefix("fulfillment_orders", fulfillmentID)
+	path := fmt.Sprintf("%s/locations_for_move.json", prefix)
+	resource := new(FulfillmentOrderLocationsForMoveResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.LocationsForMove, err
+}
+
+const fulfillmentOrderLineItemFragment = `
+fragment fulfillmentOrderLineItemFields on FulfillmentOrderLineItem {
+  id
+  totalQuantity
+  remainingQuantity
+  lineItem { legacyResourceId }
+}
+`
+
+// fulfillmentOrderFragment fetches the first 100 line items, which keeps the
+// requested cost of a split, returning three fulfillment orders, under
+// Shopify's limit of 1000 points. The other pages are fetched with
+// fulfillmentOrderLineItemsQuery.
+const fulfillmentOrderFragment = `
+fragment fulfillmentOrderFields on FulfillmentOrder {
+  legacyResourceId
+  status
+  requestStatus
+  order { legacyResourceId }
+  assignedLocation { location { legacyResourceId } }
+  lineItems(first: 100) {
+    edges { node { ...fulfillmentOrderLineItemFields } }
+    pageInfo { hasNextPage endCursor }
+  }
+}
+` + fulfillmentOrderLineItemFragment
+
+const fulfillmentOrderLineItemsQuery = `
+query fulfillmentOrderLineItems($id: ID!, $after: String) {
+  node(id: $id) {
+    ... on FulfillmentOrder {
+      lineItems(first: 100, after: $after) {
+        edges { node { ...fulfillmentOrderLineItemFields } }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}
+` + fulfillmentOrderLineItemFragment
+
+const fulfillmentOrderSplitMutation = `
+mutation fulfillmentOrderSplit($fulfillmentOrderSplits: [FulfillmentOrderSplitInput!]!) {
+  fulfillmentOrderSplit(fulfillmentOrderSplits: $fulfillmentOrderSplits) {
+    fulfillmentOrderSplits {
+      fulfillmentOrder { ...fulfillmentOrderFields }
+      remainingFulfillmentOrder { ...fulfillmentOrderFields }
+      replacementFulfillmentOrder { ...fulfillmentOrderFields }
+    }
+    userErrors { field message }
+  }
+}
+` + fulfillmentOrderFragment
+
+const fulfillmentOrderMergeMutation = `
+mutation fulfillmentOrderMerge($fulfillmentOrderMergeInputs: [FulfillmentOrderMergeInput!]!) {
+  fulfillmentOrderMerge(fulfillmentOrderMergeInputs: $fulfillmentOrderMergeInputs) {
+    fulfillmentOrderMerges {
+      fulfillmentOrder { ...fulfillmentOrderFields }
+    }
+    userErrors { field message }
+  }
+}
+` + fulfillmentOrderFragment
+
+type graphQLFulfillmentOrder struct {
+	LegacyResourceID int64                  `json:"legacyResourceId,string"`
+	Status           string                 `json:"status"`
+	RequestStatus    string                 `json:"requestStatus"`
+	Order            *graphQLLegacyResource `json:"order"`
+	AssignedLocation *struct {
+		Location *graphQLLegacyResource `json:"location"`
+	} `json:"assignedLocation"`
+	LineItems graphQLFulfillmentOrderLineItems `json:"lineItems"`
+}
+
+type graphQLFulfillmentOrderLineItems struct {
+	Edges []struct {
+		Node struct {
+			ID                string                 `json:"id"`
+			TotalQuantity     int64                  `json:"totalQuantity"`
+			RemainingQuantity int64                  `json:"remainingQuantity"`
+			LineItem          *graphQLLegacyResource `json:"lineItem"`
+		} `json:"node"`
+	} `json:"edges"`
+	PageInfo graphQLPageInfo `json:"pageInfo"`
+}
+
+// fulfillmentOrder converts the fulfillment order to its REST representation,
+// the GraphQL enums are lower cased to match the REST values
+func (o *graphQLFulfillmentOrder) fulfillmentOrder() *FulfillmentOrder {
+	if o == nil {
+		return nil
+	}
+	order := &FulfillmentOrder{
+		Id:            o.LegacyResourceID,
+		Status:        strings.ToLower(o.Status),
+		RequestStatus: strings.ToLower(o.RequestStatus),
+		LineItems:     make([]FulfillmentOrderLineItem, 0, len(o.LineItems.Edges)),
+	}
+	if o.Order != nil {
+		order.OrderId = o.Order.LegacyResourceID
+	}
+	if o.AssignedLocation != nil && o.AssignedLocation.Location != nil {
+		order.AssignedLocationId = o.AssignedLocation.Location.LegacyResourceID
+	}
+	for _, edge := range o.LineItems.Edges {
+		item := FulfillmentOrderLineItem{
+			Id:                  graphQLLegacyID(edge.Node.ID),
+			FulfillmentOrderId:  order.Id,
+			Quantity:            edge.Node.TotalQuantity,
+			FulfillableQuantity: edge.Node.RemainingQuantity,
+		}
+		if edge.Node.LineItem != nil {
+			item.LineItemId = edge.Node.LineItem.LegacyResourceID
+		}
+		order.LineItems = append(order.LineItems, item)
+	}
+	return order
+}
+
+// nextLineItems fetches the pages of line items of the fulfillment orders
+// that follow the first one
+func (s *FulfillmentOrderServiceOp) nextLineItems(orders ...*graphQLFulfillmentOrder) error {
+	for _, order := range orders {
+		if order == nil {
+			continue
+		}
+		id := graphQLID("FulfillmentOrder", order.LegacyResourceID)
+		for order.LineItems.PageInfo.HasNextPage {
+			resp := struct {
+				Node *graphQLFulfillmentOrder `json:"node"`
+			}{}
+			vars := map[string]interface{}{
+				"id":    id,
+				"after": order.LineItems.PageInfo.EndCursor,
+			}
+			if err := s.client.GraphQL.Query(fulfillmentOrderLineItemsQuery, vars, &resp); err != nil {
+				return err
+			}
+			if resp.Node == nil {
+				return ResponseError{Status: 200, Message: "missing fulfillment order " + id + " in response"}
+			}
+
+			page := resp.Node.LineItems
+			if page.PageInfo.HasNextPage && page.PageInfo.EndCursor == order.LineItems.PageInfo.EndCursor {
+				return ResponseError{Status: 200, Message: "line items of fulfillment order " + id + " did not advance"}
+			}
+			order.LineItems.Edges = append(order.LineItems.Edges, page.Edges...)
+			order.LineItems.PageInfo = page.PageInfo
+		}
+	}
+	return nil
+}
+
+// fulfillmentOrderLineItemsInput returns the GraphQL input of line item
+// quantities
+func fulfillmentOrderLineItemsInput(lineItems []FulfillmentOrderLineItemQuantity) []map[string]interface{} {
+	input := make([]map[string]interface{}, 0, len(lineItems))
+	for _, lineItem := range lineItems {
+		input = append(input, map[string]interface{}{
+			"id":       graphQLID("FulfillmentOrderLineItem", lineItem.Id),
+			"quantity": lineItem.Quantity,
+		})
+	}
+	return input
+}
+
+// Split splits the given quantities of line items off a fulfillment order into
+// a new fulfillment order. Splitting is only available in the GraphQL Admin API.
+func (s *FulfillmentOrderServiceOp) Split(fulfillmentID int64, lineItems []FulfillmentOrderLineItemQuantity) (*FulfillmentOrderSplitResult, error) {
+	resp := struct {
+		FulfillmentOrderSplit *struct {
+			FulfillmentOrderSplits []struct {
+				FulfillmentOrder            *graphQLFulfillmentOrder `json:"fulfillmentOrder"`
+				RemainingFulfillmentOrder   *graphQLFulfillmentOrder `json:"remainingFulfillmentOrder"`
+				ReplacementFulfillmentOrder *graphQLFulfillmentOrder `json:"replacementFulfillmentOrder"`
+			} `json:"fulfillmentOrderSplits"`
+			UserErrors []graphQLUserError `json:"userErrors"`
+		} `json:"fulfillmentOrderSplit"`
+	}{}
+
+	vars := map[string]interface{}{
+		"fulfillmentOrderSplits": []map[string]interface{}{{
+			"fulfillmentOrderId":        graphQLID("FulfillmentOrder", fulfillmentID),
+			"fulfillmentOrderLineItems": fulfillmentOrderLineItemsInput(lineItems),
+		}},
+	}
+	if err := s.client.GraphQL.Query(fulfillmentOrderSplitMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	payload := resp.FulfillmentOrderSplit
+	if payload == nil {
+		return nil, ResponseError{Status: 200, Message: "missing fulfillmentOrderSplit in response"}
+	}
+	if err := userErrorsToError(payload.UserErrors); err != nil {
+		return nil, err
+	}
+	if len(payload.FulfillmentOrderSplits) == 0 {
+		return nil, ResponseError{Status: 200, Message: "missing fulfillmentOrderSplits in fulfillmentOrderSplit response"}
+	}
+
+	split := payload.FulfillmentOrderSplits[0]
+	if err := s.nextLineItems(split.FulfillmentOrder, split.RemainingFulfillmentOrder, split.ReplacementFulfillmentOrder); err != nil {
+		return nil, err
+	}
+	return &FulfillmentOrderSplitResult{
+		FulfillmentOrder:            split.FulfillmentOrder.fulfillmentOrder(),
+		RemainingFulfillmentOrder:   split.RemainingFulfillmentOrder.fulfillmentOrder(),
+		ReplacementFulfillmentOrder: split.ReplacementFulfillmentOrder.fulfillmentOrder(),
+	}, nil
+}
+
+// Merge merges fulfillment orders of the same order into one fulfillment
+// order. Merging is only available in the GraphQL Admin API.
+func (s *FulfillmentOrderServiceOp) Merge(intents []FulfillmentOrderMergeIntent) (*FulfillmentOrder, error) {
+	resp := struct {
+		FulfillmentOrderMerge *struct {
+			FulfillmentOrderMerges []struct {
+				FulfillmentOrder *graphQLFulfillmentOrder `json:"fulfillmentOrder"`
+			} `json:"fulfillmentOrderMerges"`
+			UserErrors []graphQLUserError `json:"userErrors"`
+		} `json:"fulfillmentOrderMerge"`
+	}{}
+
+	mergeIntents := make([]map[string]interface{}, 0, len(intents))
+	for _, intent := range intents {
+		mergeIntent := map[string]interface{}{
+			"fulfillmentOrderId": graphQLID("FulfillmentOrder", intent.FulfillmentOrderId),
+		}
+		if len(intent.LineItems) > 0 {
+			mergeIntent["fulfillmentOrderLineItems"] = fulfillmentOrderLineItemsInput(intent.LineItems)
+		}
+		mergeIntents = append(mergeIntents, mergeIntent)
+	}
+	vars := map[string]interface{}{
+		"fulfillmentOrderMergeInputs": []map[string]interface{}{{
+			"mergeIntents": mergeIntents,
+		}},
+	}
+	if err := s.client.GraphQL.Query(fulfillmentOrderMergeMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	payload := resp.FulfillmentOrderMerge
+	if payload == nil {
+		return nil, ResponseError{Status: 200, Message: "missing fulfillmentOrderMerge in response"}
+	}
+	if err := userErrorsToError(payload.UserErrors); err != nil {
+		return nil, err
+	}
+	if len(payload.FulfillmentOrderMerges) == 0 || payload.FulfillmentOrderMerges[0].FulfillmentOrder == nil {
+		return nil, ResponseError{Status: 200, Message: "missing fulfillmentOrder in fulfillmentOrderMerge response"}
+	}
+	merged := payload.FulfillmentOrderMerges[0].FulfillmentOrder
+	if err := s.nextLineItems(merged); err != nil {
+		return nil, err
+	}
+	return merged.fulfillmentOrder(), nil
+}
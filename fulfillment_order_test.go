@@ -3,7 +3,9 @@ package goshopify
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -216,3 +218,196 @@ func TestFulfillmentOrderSetDeadline(t *testing.T) {
 		t.Errorf("FulfillmentOrder.SetDeadline returned error: %v", err)
 	}
 }
+
+func TestFulfillmentOrderLocationsForMove(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1046000818/locations_for_move.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_order_locations_for_move.json")))
+
+	locations, err := client.FulfillmentOrder.LocationsForMove(1046000818)
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.LocationsForMove returned error: %v", err)
+	}
+	if len(locations) != 3 {
+		t.Fatalf("FulfillmentOrder.LocationsForMove returned %d locations, expected 3", len(locations))
+	}
+
+	expected := FulfillmentOrderLocationForMove{
+		Location: Location{ID: 655441491, Name: "Charlie Location"},
+		Message:  "All items are stocked at this location.",
+		Movable:  true,
+	}
+	if !reflect.DeepEqual(locations[2], expected) {
+		t.Errorf("FulfillmentOrder.LocationsForMove returned %+v, expected %+v", locations[2], expected)
+	}
+
+	if locations[1].Movable || locations[1].Message != "No items are stocked at this location." {
+		t.Errorf("FulfillmentOrder.LocationsForMove returned %+v, expected an unmovable location with its reason", locations[1])
+	}
+}
+
+func TestFulfillmentOrderSplit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var req graphQLRequest
+	registerGraphQLResponder(&req, loadFixture("fulfillment_order_split.json"))
+
+	result, err := client.FulfillmentOrder.Split(1046000818, []FulfillmentOrderLineItemQuantity{{Id: 1058737594, Quantity: 2}})
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.Split returned error: %v", err)
+	}
+
+	expectedVars := map[string]interface{}{
+		"fulfillmentOrderSplits": []interface{}{
+			map[string]interface{}{
+				"fulfillmentOrderId": "gid://shopify/FulfillmentOrder/1046000818",
+				"fulfillmentOrderLineItems": []interface{}{
+					map[string]interface{}{"id": "gid://shopify/FulfillmentOrderLineItem/1058737594", "quantity": float64(2)},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(req.Variables, expectedVars) {
+		t.Errorf("FulfillmentOrder.Split sent variables %+v, expected %+v", req.Variables, expectedVars)
+	}
+	// larger pages exceed the query cost limit of 1000 points
+	if !strings.Contains(req.Query, " lineItems(first: 100)") {
+		t.Errorf("FulfillmentOrder.Split sent query without lineItems(first: 100): %s", req.Query)
+	}
+
+	expected := &FulfillmentOrderSplitResult{
+		FulfillmentOrder: &FulfillmentOrder{
+			Id:                 1046000818,
+			OrderId:            450789469,
+			AssignedLocationId: 24826418,
+			Status:             "open",
+			RequestStatus:      "unsubmitted",
+			LineItems: []FulfillmentOrderLineItem{
+				{Id: 1058737594, FulfillmentOrderId: 1046000818, LineItemId: 466157049, Quantity: 1, FulfillableQuantity: 1},
+			},
+		},
+		RemainingFulfillmentOrder: &FulfillmentOrder{
+			Id:                 1046000819,
+			OrderId:            450789469,
+			AssignedLocationId: 24826418,
+			Status:             "open",
+			RequestStatus:      "unsubmitted",
+			LineItems: []FulfillmentOrderLineItem{
+				{Id: 1058737595, FulfillmentOrderId: 1046000819, LineItemId: 466157049, Quantity: 2, FulfillableQuantity: 2},
+			},
+		},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("FulfillmentOrder.Split returned %+v, expected %+v", result, expected)
+	}
+}
+
+func TestFulfillmentOrderSplitUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var req graphQLRequest
+	registerGraphQLResponder(&req, []byte(`{"data": {"fulfillmentOrderSplit": {"fulfillmentOrderSplits": [], "userErrors": [{"field": ["fulfillmentOrderSplits", "0", "fulfillmentOrderLineItems"], "message": "Quantity must be less than the remaining quantity"}]}}}`))
+
+	result, err := client.FulfillmentOrder.Split(1046000818, []FulfillmentOrderLineItemQuantity{{Id: 1058737594, Quantity: 5}})
+	if result != nil {
+		t.Errorf("FulfillmentOrder.Split returned %+v, expected nil", result)
+	}
+
+	expectedErr := ResponseError{Status: 200, Errors: []string{"fulfillmentOrderSplits.0.fulfillmentOrderLineItems: Quantity must be less than the remaining quantity"}}
+	if !reflect.DeepEqual(err, expectedErr) {
+		t.Errorf("FulfillmentOrder.Split returned error %#v, expected %#v", err, expectedErr)
+	}
+}
+
+func TestFulfillmentOrderMerge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var req graphQLRequest
+	registerGraphQLResponder(&req, []byte(`{"data": {"fulfillmentOrderMerge": {"fulfillmentOrderMerges": [{"fulfillmentOrder": {"legacyResourceId": "1046000818", "status": "OPEN", "requestStatus": "UNSUBMITTED", "lineItems": {"edges": []}}}], "userErrors": []}}}`))
+
+	order, err := client.FulfillmentOrder.Merge([]FulfillmentOrderMergeIntent{
+		{FulfillmentOrderId: 1046000818},
+		{FulfillmentOrderId: 1046000819, LineItems: []FulfillmentOrderLineItemQuantity{{Id: 1058737595, Quantity: 2}}},
+	})
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.Merge returned error: %v", err)
+	}
+
+	expectedVars := map[string]interface{}{
+		"fulfillmentOrderMergeInputs": []interface{}{
+			map[string]interface{}{
+				"mergeIntents": []interface{}{
+					map[string]interface{}{"fulfillmentOrderId": "gid://shopify/FulfillmentOrder/1046000818"},
+					map[string]interface{}{
+						"fulfillmentOrderId": "gid://shopify/FulfillmentOrder/1046000819",
+						"fulfillmentOrderLineItems": []interface{}{
+							map[string]interface{}{"id": "gid://shopify/FulfillmentOrderLineItem/1058737595", "quantity": float64(2)},
+						},
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(req.Variables, expectedVars) {
+		t.Errorf("FulfillmentOrder.Merge sent variables %+v, expected %+v", req.Variables, expectedVars)
+	}
+
+	expected := &FulfillmentOrder{Id: 1046000818, Status: "open", RequestStatus: "unsubmitted", LineItems: []FulfillmentOrderLineItem{}}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("FulfillmentOrder.Merge returned %+v, expected %+v", order, expected)
+	}
+}
+
+func TestFulfillmentOrderMergeLineItemPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	lineItem := func(id int) string {
+		return fmt.Sprintf(`{"node": {"id": "gid://shopify/FulfillmentOrderLineItem/%d", "totalQuantity": 1, "remainingQuantity": 1, "lineItem": {"legacyResourceId": "%d"}}}`, id, id+100)
+	}
+	responses := map[string]string{
+		"fulfillmentOrderMerge": `{"data": {"fulfillmentOrderMerge": {"fulfillmentOrderMerges": [{"fulfillmentOrder": {"legacyResourceId": "1046000818", "status": "OPEN", "requestStatus": "UNSUBMITTED", ` +
+			`"lineItems": {"edges": [` + lineItem(1) + `], "pageInfo": {"hasNextPage": true, "endCursor": "cursor1"}}}}], "userErrors": []}}}`,
+		"fulfillmentOrderLineItems:cursor1": `{"data": {"node": {"lineItems": {"edges": [` + lineItem(2) + `], "pageInfo": {"hasNextPage": false, "endCursor": "cursor2"}}}}}`,
+	}
+
+	var requests []string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(r *http.Request) (*http.Response, error) {
+			req := graphQLRequest{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				return nil, err
+			}
+			key := "fulfillmentOrderMerge"
+			if strings.Contains(req.Query, "query fulfillmentOrderLineItems(") {
+				if req.Variables["id"] != "gid://shopify/FulfillmentOrder/1046000818" {
+					t.Errorf("FulfillmentOrder.Merge sent line items query for %v", req.Variables["id"])
+				}
+				key = fmt.Sprintf("fulfillmentOrderLineItems:%v", req.Variables["after"])
+			}
+			requests = append(requests, key)
+			body, ok := responses[key]
+			if !ok {
+				return httpmock.NewStringResponse(400, `{"errors": "unexpected request"}`), nil
+			}
+			return httpmock.NewStringResponse(200, body), nil
+		})
+
+	order, err := client.FulfillmentOrder.Merge([]FulfillmentOrderMergeIntent{{FulfillmentOrderId: 1046000818}, {FulfillmentOrderId: 1046000819}})
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.Merge returned error: %v", err)
+	}
+
+	expectedRequests := []string{"fulfillmentOrderMerge", "fulfillmentOrderLineItems:cursor1"}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("FulfillmentOrder.Merge sent requests %v, expected %v", requests, expectedRequests)
+	}
+	if len(order.LineItems) != 2 || order.LineItems[1].Id != 2 || order.LineItems[1].LineItemId != 102 || order.LineItems[1].FulfillmentOrderId != 1046000818 {
+		t.Errorf("FulfillmentOrder.Merge returned line items %+v, expected the line items of both pages", order.LineItems)
+	}
+}
@@ -45,6 +45,10 @@ type App struct {
 	Scope       string
 	Password    string
 	Client      *Client // see GetAccessToken
+
+	// PerUser requests an online (per-user) access token instead of an
+	// offline one, see AuthorizeUrl
+	PerUser bool
 }
 
 type RateLimitInfo struct {
@@ -77,6 +81,14 @@ type Client struct {
 	// A permanent access token
 	token string
 
+	// Expiry of an online access token, zero for tokens that do not expire
+	// see WithTokenExpiry
+	tokenExpiresAt time.Time
+
+	// Shop domains accepted by NewClientE besides myshopify.com, see
+	// WithAllowedShopDomains
+	allowedShopDomains []string
+
 	// max number of retries, defaults to 0 for no retries see WithRetry option
 	retries  int
 	attempts int
@@ -130,6 +142,20 @@ type Client struct {
 	FulfillmentRequest         FulfillmentRequestService
 	PaymentsTransactions       PaymentsTransactionsService
 	OrderRisk                  OrderRiskService
+	Refund                     RefundService
+	OrderEdit                  OrderEditService
+	Event                      EventService
+	Article                    ArticleService
+	Comment                    CommentService
+	CustomerSavedSearch        CustomerSavedSearchService
+	Country                    CountryService
+	Province                   ProvinceService
+	TenderTransaction          TenderTransactionService
+	Dispute                    DisputeService
+	Balance                    BalanceService
+	CollectionListing          CollectionListingService
+	ResourceFeedback           ResourceFeedbackService
+	CancellationRequest        CancellationRequestService
 }
 
 // A general response error that follows a similar layout to Shopify's response
@@ -189,6 +215,16 @@ type RateLimitError struct {
 	RetryAfter int
 }
 
+// AccessTokenExpiredError is returned when a request is attempted with an
+// online access token whose expiry, set with WithTokenExpiry, has passed.
+type AccessTokenExpiredError struct {
+	ExpiresAt time.Time
+}
+
+func (e AccessTokenExpiredError) Error() string {
+	return fmt.Sprintf("access token expired at %s", e.ExpiresAt.Format(time.RFC3339))
+}
+
 // Creates an API request. A relative URL can be provided in urlStr, which will
 // be resolved to the BaseURL of the Client. Relative URLS should always be
 // specified without a preceding slash. If specified, the value pointed to by
@@ -251,6 +287,14 @@ func (app App) NewClient(shopName, token string, opts ...Option) *Client {
 	return NewClient(app, shopName, token, opts...)
 }
 
+// NewClientE returns a new Shopify API client like NewClient, but returns an
+// error instead of panicking when shopName is not a valid myshopify domain,
+// see ValidateShopDomain.
+// a.NewClientE(shopName, token, opts) is equivalent to NewClientE(a, shopName, token, opts)
+func (app App) NewClientE(shopName, token string, opts ...Option) (*Client, error) {
+	return NewClientE(app, shopName, token, opts...)
+}
+
 // Returns a new Shopify API client with an already authenticated shopname and
 // token. The shopName parameter is the shop's myshopify domain,
 // e.g. "theshop.myshopify.com", or simply "theshop"
@@ -260,6 +304,46 @@ func NewClient(app App, shopName, token string, opts ...Option) *Client {
 		panic(err) // something really wrong with shopName
 	}
 
+	return newClient(app, baseURL, token, opts...)
+}
+
+// NewClientE returns a new Shopify API client like NewClient, but returns an
+// error instead of panicking when shopName is not a valid myshopify domain,
+// see ValidateShopDomain. Other domains, e.g. for custom test hosts, can be
+// allowed with the WithAllowedShopDomains option.
+func NewClientE(app App, shopName, token string, opts ...Option) (*Client, error) {
+	c := newClient(app, nil, token, opts...)
+
+	// domains are case insensitive, lower case the name so that ShopFullName
+	// recognizes names that already end with myshopify.com
+	fullName := strings.ToLower(strings.Trim(strings.TrimSpace(shopName), "."))
+	if !hasShopDomain(fullName, c.allowedShopDomains) {
+		fullName = ShopFullName(fullName)
+	}
+	if err := ValidateShopDomain(fullName, c.allowedShopDomains...); err != nil {
+		return nil, err
+	}
+
+	baseURL, err := url.Parse(fmt.Sprintf("https://%s", fullName))
+	if err != nil {
+		return nil, err
+	}
+	c.baseURL = baseURL
+
+	return c, nil
+}
+
+// hasShopDomain reports whether shop ends with one of the domains
+func hasShopDomain(shop string, domains []string) bool {
+	for _, domain := range domains {
+		if strings.HasSuffix(strings.ToLower(shop), "."+strings.ToLower(domain)) {
+			return true
+		}
+	}
+	return false
+}
+
+func newClient(app App, baseURL *url.URL, token string, opts ...Option) *Client {
 	c := &Client{
 		Client: &http.Client{
 			Timeout: time.Second * defaultHttpTimeout,
@@ -318,6 +402,20 @@ func NewClient(app App, shopName, token string, opts ...Option) *Client {
 	c.FulfillmentRequest = &FulfillmentRequestServiceOp{client: c}
 	c.PaymentsTransactions = &PaymentsTransactionsServiceOp{client: c}
 	c.OrderRisk = &OrderRiskServiceOp{client: c}
+	c.Refund = &RefundServiceOp{client: c}
+	c.OrderEdit = &OrderEditServiceOp{client: c}
+	c.Event = &EventServiceOp{client: c}
+	c.Article = &ArticleServiceOp{client: c}
+	c.Comment = &CommentServiceOp{client: c}
+	c.CustomerSavedSearch = &CustomerSavedSearchServiceOp{client: c}
+	c.Country = &CountryServiceOp{client: c}
+	c.Province = &ProvinceServiceOp{client: c}
+	c.TenderTransaction = &TenderTransactionServiceOp{client: c}
+	c.Dispute = &DisputeServiceOp{client: c}
+	c.Balance = &BalanceServiceOp{client: c}
+	c.CollectionListing = &CollectionListingServiceOp{client: c}
+	c.ResourceFeedback = &ResourceFeedbackServiceOp{client: c}
+	c.CancellationRequest = &CancellationRequestServiceOp{client: c}
 
 	// apply any options
 	for _, opt := range opts {
@@ -345,6 +443,11 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 	var err error
 	retries := c.retries
 	c.attempts = 0
+
+	if !c.tokenExpiresAt.IsZero() && !time.Now().Before(c.tokenExpiresAt) {
+		return nil, AccessTokenExpiredError{ExpiresAt: c.tokenExpiresAt}
+	}
+
 	c.logRequest(req)
 
 	for {
@@ -96,6 +96,74 @@ func TestAppNewClientWithNoToken(t *testing.T) {
 	}
 }
 
+func TestNewClientE(t *testing.T) {
+	for _, shopName := range []string{"fooshop", "fooshop.myshopify.com", "FooShop.MyShopify.com"} {
+		testClient, err := NewClientE(app, shopName, "abcd", WithVersion(testApiVersion))
+		if err != nil {
+			t.Fatalf("NewClientE(%s) returned error: %v", shopName, err)
+		}
+		expected := "https://fooshop.myshopify.com"
+		if testClient.baseURL.String() != expected {
+			t.Errorf("NewClientE BaseURL = %v, expected %v", testClient.baseURL.String(), expected)
+		}
+		if testClient.pathPrefix != fmt.Sprintf("admin/api/%s", testApiVersion) {
+			t.Errorf("NewClientE did not apply options, pathPrefix = %s", testClient.pathPrefix)
+		}
+	}
+}
+
+func TestNewClientEAllowedShopDomains(t *testing.T) {
+	for _, shopName := range []string{"fooshop.myshopify.io", "fooshop.MyShopify.io."} {
+		testClient, err := NewClientE(app, shopName, "abcd", WithAllowedShopDomains("myshopify.io"))
+		if err != nil {
+			t.Fatalf("NewClientE(%s) returned error: %v", shopName, err)
+		}
+		expected := "https://fooshop.myshopify.io"
+		if testClient.baseURL.String() != expected {
+			t.Errorf("NewClientE BaseURL = %v, expected %v", testClient.baseURL.String(), expected)
+		}
+	}
+
+	testClient, err := NewClientE(app, "fooshop", "abcd", WithAllowedShopDomains("myshopify.io"))
+	if err != nil {
+		t.Fatalf("NewClientE(fooshop) returned error: %v", err)
+	}
+	if testClient.baseURL.String() != "https://fooshop.myshopify.com" {
+		t.Errorf("NewClientE BaseURL = %v, expected https://fooshop.myshopify.com", testClient.baseURL.String())
+	}
+
+	for _, shopName := range []string{"fooshop.myshopify.io", "foo shop.myshopify.io", "evil.com/?myshopify.io"} {
+		options := []Option{}
+		if shopName != "fooshop.myshopify.io" {
+			options = append(options, WithAllowedShopDomains("myshopify.io"))
+		}
+		testClient, err := NewClientE(app, shopName, "abcd", options...)
+		if err == nil {
+			t.Errorf("NewClientE(%s) should have returned an error", shopName)
+		}
+		if testClient != nil {
+			t.Errorf("NewClientE(%s) returned client %v, expected nil", shopName, testClient)
+		}
+	}
+}
+
+func TestNewClientEBadShopName(t *testing.T) {
+	for _, shopName := range []string{
+		"foo shop",
+		"foo, shop, stuff commas",
+		"evil.com/?myshopify.com",
+		"evil.com#myshopify.com",
+	} {
+		testClient, err := app.NewClientE(shopName, "abcd")
+		if err == nil {
+			t.Errorf("NewClientE(%s) should have returned an error", shopName)
+		}
+		if testClient != nil {
+			t.Errorf("NewClientE(%s) returned client %v, expected nil", shopName, testClient)
+		}
+	}
+}
+
 func TestBadShopNamePanic(t *testing.T) {
 	func() {
 		var tried string
@@ -493,6 +561,36 @@ func TestClientDoAutoApiVersion(t *testing.T) {
 	}
 }
 
+func TestClientDoTokenExpired(t *testing.T) {
+	setup()
+	defer teardown()
+
+	sent := false
+	shopUrl := fmt.Sprintf("https://fooshop.myshopify.com/%s/foo/1", client.pathPrefix)
+	httpmock.RegisterResponder("GET", shopUrl, func(req *http.Request) (*http.Response, error) {
+		sent = true
+		return httpmock.NewStringResponse(200, `{}`), nil
+	})
+
+	expiresAt := time.Now().Add(-time.Minute)
+	WithTokenExpiry(expiresAt)(client)
+
+	err := client.Get("foo/1", nil, nil)
+	expected := AccessTokenExpiredError{ExpiresAt: expiresAt}
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf("Client.Get() returned error %#v, expected %#v", err, expected)
+	}
+	if sent {
+		t.Errorf("Client.Get() sent a request with an expired token")
+	}
+
+	WithTokenExpiry(time.Now().Add(time.Hour))(client)
+	err = client.Get("foo/1", nil, nil)
+	if err != nil {
+		t.Errorf("Client.Get() with unexpired token returned error: %v", err)
+	}
+}
+
 func TestCustomHTTPClientDo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1,8 +1,13 @@
 package goshopify
 
 import (
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // GraphQLService is an interface to interact with the graphql endpoint
@@ -62,6 +67,80 @@ type graphQLErrorLocation struct {
 	Column int `json:"column"`
 }
 
+// graphQLUserError is an error returned in the userErrors field of a mutation
+type graphQLUserError struct {
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+}
+
+// userErrorsToError returns a ResponseError listing the user errors of a
+// mutation, or nil if there are none
+func userErrorsToError(userErrors []graphQLUserError) error {
+	if len(userErrors) == 0 {
+		return nil
+	}
+
+	responseError := ResponseError{Status: 200}
+	for _, userError := range userErrors {
+		message := userError.Message
+		if len(userError.Field) > 0 {
+			message = fmt.Sprintf("%s: %s", strings.Join(userError.Field, "."), message)
+		}
+		responseError.Errors = append(responseError.Errors, message)
+	}
+	return responseError
+}
+
+// graphQLMoneyBag is a MoneyBag of the GraphQL Admin API
+type graphQLMoneyBag struct {
+	ShopMoney        graphQLMoney `json:"shopMoney"`
+	PresentmentMoney graphQLMoney `json:"presentmentMoney"`
+}
+
+type graphQLMoney struct {
+	Amount       *decimal.Decimal `json:"amount"`
+	CurrencyCode string           `json:"currencyCode"`
+}
+
+// amountSet converts the money bag to its REST representation
+func (m *graphQLMoneyBag) amountSet() *AmountSet {
+	if m == nil {
+		return nil
+	}
+	return &AmountSet{
+		ShopMoney:        AmountSetEntry{Amount: m.ShopMoney.Amount, CurrencyCode: m.ShopMoney.CurrencyCode},
+		PresentmentMoney: AmountSetEntry{Amount: m.PresentmentMoney.Amount, CurrencyCode: m.PresentmentMoney.CurrencyCode},
+	}
+}
+
+// graphQLLegacyResource is a resource of which only the REST id is queried
+type graphQLLegacyResource struct {
+	LegacyResourceID int64 `json:"legacyResourceId,string"`
+}
+
+// graphQLPageInfo is the page info of a connection, used to fetch its next
+// page
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// graphQLID returns the global id of a resource from its REST id, e.g.
+// gid://shopify/Order/450789469
+func graphQLID(resource string, id int64) string {
+	return fmt.Sprintf("gid://shopify/%s/%d", resource, id)
+}
+
+// graphQLLegacyID returns the REST id of a global id, or 0 if the global id
+// does not end with a numeric id
+func graphQLLegacyID(gid string) int64 {
+	id, err := strconv.ParseInt(gid[strings.LastIndex(gid, "/")+1:], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
 // Query creates a graphql query against the Shopify API
 // the "data" portion of the response is unmarshalled into resp
 func (s *GraphQLServiceOp) Query(q string, vars, resp interface{}) error {
@@ -0,0 +1,22 @@
+//go:build ignore
+// +build ignore
+
+// gen.go writes services.go, run it with go generate.
+package main
+
+import (
+	"io/ioutil"
+	"log"
+
+	"github.com/bold-commerce/go-shopify/v3/mocks/internal/mockgen"
+)
+
+func main() {
+	src, err := mockgen.Generate("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("services.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,269 @@
+// Package mockgen generates the mock implementations of the goshopify service
+// interfaces found in the mocks package.
+package mockgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const goshopifyImport = "github.com/bold-commerce/go-shopify/v3"
+
+type method struct {
+	name    string
+	params  []string
+	results []string
+	// variadic is set when the last parameter is variadic
+	variadic bool
+}
+
+// Generate parses the goshopify package in dir and returns the source of the
+// mocks for every interface whose name ends with "Service".
+func Generate(dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	pkg, ok := pkgs["goshopify"]
+	if !ok {
+		return nil, fmt.Errorf("no goshopify package in %s", dir)
+	}
+
+	interfaces := map[string]*ast.InterfaceType{}
+	imports := map[string]string{}
+	for _, file := range pkg.Files {
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = path
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if it, ok := ts.Type.(*ast.InterfaceType); ok {
+					interfaces[ts.Name.Name] = it
+				}
+			}
+		}
+	}
+
+	names := []string{}
+	for name := range interfaces {
+		if strings.HasSuffix(name, "Service") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	g := &generator{interfaces: interfaces, imports: imports, used: map[string]bool{}}
+	var body bytes.Buffer
+	for _, name := range names {
+		methods, err := g.methods(name)
+		if err != nil {
+			return nil, err
+		}
+		g.writeMock(&body, name, methods)
+	}
+	g.writeRegistry(&body, names)
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by mockgen; DO NOT EDIT.\n\npackage mocks\n\nimport (\n")
+	paths := []string{}
+	for name := range g.used {
+		paths = append(paths, imports[name])
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	if len(paths) > 0 {
+		out.WriteString("\n")
+	}
+	fmt.Fprintf(&out, "\tgoshopify %q\n)\n\n", goshopifyImport)
+	out.Write(body.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+type generator struct {
+	interfaces map[string]*ast.InterfaceType
+	imports    map[string]string
+	// used records the packages other than goshopify referenced by the mocks
+	used map[string]bool
+}
+
+// methods returns the methods of the named interface, expanding embedded
+// interfaces in place
+func (g *generator) methods(name string) ([]method, error) {
+	it, ok := g.interfaces[name]
+	if !ok {
+		return nil, fmt.Errorf("interface %s not found", name)
+	}
+
+	methods := []method{}
+	for _, field := range it.Methods.List {
+		switch t := field.Type.(type) {
+		case *ast.Ident:
+			embedded, err := g.methods(t.Name)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, embedded...)
+		case *ast.FuncType:
+			m := method{name: field.Names[0].Name}
+			for _, p := range fieldTypes(t.Params) {
+				if ellipsis, ok := p.(*ast.Ellipsis); ok {
+					m.variadic = true
+					m.params = append(m.params, "..."+g.typeString(ellipsis.Elt))
+					continue
+				}
+				m.params = append(m.params, g.typeString(p))
+			}
+			for _, r := range fieldTypes(t.Results) {
+				m.results = append(m.results, g.typeString(r))
+			}
+			methods = append(methods, m)
+		default:
+			return nil, fmt.Errorf("unsupported method %T in interface %s", t, name)
+		}
+	}
+	return methods, nil
+}
+
+// fieldTypes returns the type of every parameter or result in the list,
+// repeating the type of grouped names
+func fieldTypes(list *ast.FieldList) []ast.Expr {
+	types := []ast.Expr{}
+	if list == nil {
+		return types
+	}
+	for _, f := range list.List {
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			types = append(types, f.Type)
+		}
+	}
+	return types
+}
+
+// typeString formats a type expression as seen from the mocks package
+func (g *generator) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "goshopify." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X)
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "[]" + g.typeString(t.Elt)
+		}
+		var buf bytes.Buffer
+		format.Node(&buf, token.NewFileSet(), t.Len)
+		return "[" + buf.String() + "]" + g.typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + g.typeString(t.Key) + "]" + g.typeString(t.Value)
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		g.used[pkg] = true
+		return pkg + "." + t.Sel.Name
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	}
+
+	var buf bytes.Buffer
+	format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+func (g *generator) writeMock(w *bytes.Buffer, name string, methods []method) {
+	mock := name + "Mock"
+
+	fmt.Fprintf(w, "// %s is a mock implementation of goshopify.%s.\n", mock, name)
+	fmt.Fprintf(w, "// Calls to a method whose Func field is nil return zero values.\n")
+	fmt.Fprintf(w, "type %s struct {\n\tRecorder\n\n", mock)
+	for _, m := range methods {
+		fmt.Fprintf(w, "\t%sFunc func(%s) %s\n", m.name, strings.Join(m.params, ", "), resultList(m.results, false))
+	}
+	fmt.Fprintf(w, "}\n\n")
+
+	for _, m := range methods {
+		params := []string{}
+		args := []string{}
+		for i, p := range m.params {
+			params = append(params, fmt.Sprintf("a%d %s", i, p))
+			args = append(args, fmt.Sprintf("a%d", i))
+		}
+		callArgs := strings.Join(args, ", ")
+		if m.variadic {
+			callArgs += "..."
+		}
+
+		fmt.Fprintf(w, "// %s calls %sFunc and records the call.\n", m.name, m.name)
+		fmt.Fprintf(w, "func (m *%s) %s(%s) %s {\n", mock, m.name, strings.Join(params, ", "), resultList(m.results, true))
+		fmt.Fprintf(w, "\tm.record(%q", m.name)
+		for _, a := range args {
+			fmt.Fprintf(w, ", %s", a)
+		}
+		fmt.Fprintf(w, ")\n")
+		fmt.Fprintf(w, "\tif m.%sFunc == nil {\n\t\treturn\n\t}\n", m.name)
+		if len(m.results) > 0 {
+			fmt.Fprintf(w, "\treturn m.%sFunc(%s)\n", m.name, callArgs)
+		} else {
+			fmt.Fprintf(w, "\tm.%sFunc(%s)\n", m.name, callArgs)
+		}
+		fmt.Fprintf(w, "}\n\n")
+	}
+}
+
+// resultList formats method results, named r0, r1... if named is set
+func resultList(results []string, named bool) string {
+	if len(results) == 0 {
+		return ""
+	}
+	if !named && len(results) == 1 {
+		return results[0]
+	}
+	list := []string{}
+	for i, r := range results {
+		if named {
+			r = fmt.Sprintf("r%d %s", i, r)
+		}
+		list = append(list, r)
+	}
+	return "(" + strings.Join(list, ", ") + ")"
+}
+
+func (g *generator) writeRegistry(w *bytes.Buffer, names []string) {
+	fmt.Fprintf(w, "// services maps the name of every mocked service interface to a new mock\n")
+	fmt.Fprintf(w, "var services = map[string]interface{}{\n")
+	for _, name := range names {
+		fmt.Fprintf(w, "\t%q: &%sMock{},\n", name, name)
+	}
+	fmt.Fprintf(w, "}\n")
+}
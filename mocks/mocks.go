@@ -0,0 +1,67 @@
+// Package mocks provides mock implementations of the goshopify service
+// interfaces, for testing code that depends on them without a Shopify store.
+//
+// Every mock has a Func field per method which is called when set, and
+// records the calls made to it:
+//
+//	products := &mocks.ProductServiceMock{
+//		GetFunc: func(id int64, options interface{}) (*goshopify.Product, error) {
+//			return &goshopify.Product{ID: id}, nil
+//		},
+//	}
+//	client.Product = products
+//	...
+//	calls := products.CallsTo("Get")
+//
+// The mocks are generated from the goshopify sources, run go generate after
+// changing a service interface.
+package mocks
+
+//go:generate go run gen.go
+
+import "sync"
+
+// Call is a method call recorded by a mock
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder records the calls made to a mock. It is embedded in every mock.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all the calls made to the mock, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to the given method of the mock, in order.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls := []Call{}
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls recorded so far.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
@@ -0,0 +1,89 @@
+package mocks
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v3"
+	"github.com/bold-commerce/go-shopify/v3/mocks/internal/mockgen"
+)
+
+func TestMocksUpToDate(t *testing.T) {
+	expected, err := mockgen.Generate("..")
+	if err != nil {
+		t.Fatalf("mockgen.Generate returned error: %v", err)
+	}
+
+	actual, err := ioutil.ReadFile("services.go")
+	if err != nil {
+		t.Fatalf("could not read services.go: %v", err)
+	}
+
+	if !bytes.Equal(actual, expected) {
+		t.Errorf("services.go is out of date with the goshopify service interfaces, run go generate ./mocks")
+	}
+}
+
+func TestMocksImplementClientServices(t *testing.T) {
+	clientType := reflect.TypeOf(goshopify.Client{})
+	for i := 0; i < clientType.NumField(); i++ {
+		field := clientType.Field(i)
+		if field.PkgPath != "" || field.Type.Kind() != reflect.Interface || field.Type.PkgPath() != clientType.PkgPath() {
+			continue
+		}
+
+		mock, ok := services[field.Type.Name()]
+		if !ok {
+			t.Errorf("no mock for goshopify.%s used by Client.%s", field.Type.Name(), field.Name)
+			continue
+		}
+		if !reflect.TypeOf(mock).Implements(field.Type) {
+			t.Errorf("%T does not implement goshopify.%s", mock, field.Type.Name())
+		}
+	}
+}
+
+func TestMockRecordsCalls(t *testing.T) {
+	products := &ProductServiceMock{
+		GetFunc: func(id int64, options interface{}) (*goshopify.Product, error) {
+			return &goshopify.Product{ID: id}, nil
+		},
+		DeleteFunc: func(id int64) error {
+			return errors.New("not allowed")
+		},
+	}
+
+	var service goshopify.ProductService = products
+
+	product, err := service.Get(1, nil)
+	if err != nil || product.ID != 1 {
+		t.Errorf("ProductServiceMock.Get returned %+v, %v", product, err)
+	}
+	if err := service.Delete(2); err == nil {
+		t.Errorf("ProductServiceMock.Delete returned no error")
+	}
+	count, err := service.Count(nil)
+	if count != 0 || err != nil {
+		t.Errorf("ProductServiceMock.Count without CountFunc returned %d, %v, expected zero values", count, err)
+	}
+
+	expected := []Call{
+		{Method: "Get", Args: []interface{}{int64(1), nil}},
+		{Method: "Delete", Args: []interface{}{int64(2)}},
+		{Method: "Count", Args: []interface{}{nil}},
+	}
+	if !reflect.DeepEqual(products.Calls(), expected) {
+		t.Errorf("ProductServiceMock.Calls() = %+v, expected %+v", products.Calls(), expected)
+	}
+	if calls := products.CallsTo("Delete"); len(calls) != 1 {
+		t.Errorf("ProductServiceMock.CallsTo(\"Delete\") = %+v, expected 1 call", calls)
+	}
+
+	products.Reset()
+	if calls := products.Calls(); len(calls) != 0 {
+		t.Errorf("ProductServiceMock.Calls() after Reset = %+v, expected none", calls)
+	}
+}
@@ -0,0 +1,4467 @@
+// Code generated by mockgen; DO NOT EDIT.
+
+package mocks
+
+import (
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v3"
+)
+
+// AbandonedCheckoutServiceMock is a mock implementation of goshopify.AbandonedCheckoutService.
+// Calls to a method whose Func field is nil return zero values.
+type AbandonedCheckoutServiceMock struct {
+	Recorder
+
+	ListFunc func(interface{}) ([]goshopify.AbandonedCheckout, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *AbandonedCheckoutServiceMock) List(a0 interface{}) (r0 []goshopify.AbandonedCheckout, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// AccessScopesServiceMock is a mock implementation of goshopify.AccessScopesService.
+// Calls to a method whose Func field is nil return zero values.
+type AccessScopesServiceMock struct {
+	Recorder
+
+	ListFunc func(interface{}) ([]goshopify.AccessScope, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *AccessScopesServiceMock) List(a0 interface{}) (r0 []goshopify.AccessScope, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ApplicationChargeServiceMock is a mock implementation of goshopify.ApplicationChargeService.
+// Calls to a method whose Func field is nil return zero values.
+type ApplicationChargeServiceMock struct {
+	Recorder
+
+	CreateFunc   func(goshopify.ApplicationCharge) (*goshopify.ApplicationCharge, error)
+	GetFunc      func(int64, interface{}) (*goshopify.ApplicationCharge, error)
+	ListFunc     func(interface{}) ([]goshopify.ApplicationCharge, error)
+	ActivateFunc func(goshopify.ApplicationCharge) (*goshopify.ApplicationCharge, error)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *ApplicationChargeServiceMock) Create(a0 goshopify.ApplicationCharge) (r0 *goshopify.ApplicationCharge, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *ApplicationChargeServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.ApplicationCharge, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// List calls ListFunc and records the call.
+func (m *ApplicationChargeServiceMock) List(a0 interface{}) (r0 []goshopify.ApplicationCharge, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Activate calls ActivateFunc and records the call.
+func (m *ApplicationChargeServiceMock) Activate(a0 goshopify.ApplicationCharge) (r0 *goshopify.ApplicationCharge, r1 error) {
+	m.record("Activate", a0)
+	if m.ActivateFunc == nil {
+		return
+	}
+	return m.ActivateFunc(a0)
+}
+
+// ArticleServiceMock is a mock implementation of goshopify.ArticleService.
+// Calls to a method whose Func field is nil return zero values.
+type ArticleServiceMock struct {
+	Recorder
+
+	ListFunc               func(int64, interface{}) ([]goshopify.Article, error)
+	ListWithPaginationFunc func(int64, interface{}) ([]goshopify.Article, *goshopify.Pagination, error)
+	CountFunc              func(int64, interface{}) (int, error)
+	GetFunc                func(int64, int64, interface{}) (*goshopify.Article, error)
+	CreateFunc             func(int64, goshopify.Article) (*goshopify.Article, error)
+	UpdateFunc             func(int64, goshopify.Article) (*goshopify.Article, error)
+	DeleteFunc             func(int64, int64) error
+	ListAuthorsFunc        func() ([]string, error)
+	ListTagsFunc           func(interface{}) ([]string, error)
+	ListBlogTagsFunc       func(int64, interface{}) ([]string, error)
+	ListMetafieldsFunc     func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc    func(int64, interface{}) (int, error)
+	GetMetafieldFunc       func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc    func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc    func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc    func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *ArticleServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.Article, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *ArticleServiceMock) ListWithPagination(a0 int64, a1 interface{}) (r0 []goshopify.Article, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0, a1)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0, a1)
+}
+
+// Count calls CountFunc and records the call.
+func (m *ArticleServiceMock) Count(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0, a1)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *ArticleServiceMock) Get(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Article, r1 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1, a2)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *ArticleServiceMock) Create(a0 int64, a1 goshopify.Article) (r0 *goshopify.Article, r1 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *ArticleServiceMock) Update(a0 int64, a1 goshopify.Article) (r0 *goshopify.Article, r1 error) {
+	m.record("Update", a0, a1)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0, a1)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *ArticleServiceMock) Delete(a0 int64, a1 int64) (r0 error) {
+	m.record("Delete", a0, a1)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0, a1)
+}
+
+// ListAuthors calls ListAuthorsFunc and records the call.
+func (m *ArticleServiceMock) ListAuthors() (r0 []string, r1 error) {
+	m.record("ListAuthors")
+	if m.ListAuthorsFunc == nil {
+		return
+	}
+	return m.ListAuthorsFunc()
+}
+
+// ListTags calls ListTagsFunc and records the call.
+func (m *ArticleServiceMock) ListTags(a0 interface{}) (r0 []string, r1 error) {
+	m.record("ListTags", a0)
+	if m.ListTagsFunc == nil {
+		return
+	}
+	return m.ListTagsFunc(a0)
+}
+
+// ListBlogTags calls ListBlogTagsFunc and records the call.
+func (m *ArticleServiceMock) ListBlogTags(a0 int64, a1 interface{}) (r0 []string, r1 error) {
+	m.record("ListBlogTags", a0, a1)
+	if m.ListBlogTagsFunc == nil {
+		return
+	}
+	return m.ListBlogTagsFunc(a0, a1)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *ArticleServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *ArticleServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *ArticleServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *ArticleServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *ArticleServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *ArticleServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// AssetServiceMock is a mock implementation of goshopify.AssetService.
+// Calls to a method whose Func field is nil return zero values.
+type AssetServiceMock struct {
+	Recorder
+
+	ListFunc   func(int64, interface{}) ([]goshopify.Asset, error)
+	GetFunc    func(int64, string) (*goshopify.Asset, error)
+	UpdateFunc func(int64, goshopify.Asset) (*goshopify.Asset, error)
+	DeleteFunc func(int64, string) error
+}
+
+// List calls ListFunc and records the call.
+func (m *AssetServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.Asset, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *AssetServiceMock) Get(a0 int64, a1 string) (r0 *goshopify.Asset, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *AssetServiceMock) Update(a0 int64, a1 goshopify.Asset) (r0 *goshopify.Asset, r1 error) {
+	m.record("Update", a0, a1)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0, a1)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *AssetServiceMock) Delete(a0 int64, a1 string) (r0 error) {
+	m.record("Delete", a0, a1)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0, a1)
+}
+
+// AssignedFulfillmentOrderServiceMock is a mock implementation of goshopify.AssignedFulfillmentOrderService.
+// Calls to a method whose Func field is nil return zero values.
+type AssignedFulfillmentOrderServiceMock struct {
+	Recorder
+
+	GetFunc func(interface{}) ([]goshopify.AssignedFulfillmentOrder, error)
+}
+
+// Get calls GetFunc and records the call.
+func (m *AssignedFulfillmentOrderServiceMock) Get(a0 interface{}) (r0 []goshopify.AssignedFulfillmentOrder, r1 error) {
+	m.record("Get", a0)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0)
+}
+
+// BalanceServiceMock is a mock implementation of goshopify.BalanceService.
+// Calls to a method whose Func field is nil return zero values.
+type BalanceServiceMock struct {
+	Recorder
+
+	ListFunc      func() ([]goshopify.Balance, error)
+	ReconcileFunc func() ([]goshopify.BalanceReconciliation, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *BalanceServiceMock) List() (r0 []goshopify.Balance, r1 error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc()
+}
+
+// Reconcile calls ReconcileFunc and records the call.
+func (m *BalanceServiceMock) Reconcile() (r0 []goshopify.BalanceReconciliation, r1 error) {
+	m.record("Reconcile")
+	if m.ReconcileFunc == nil {
+		return
+	}
+	return m.ReconcileFunc()
+}
+
+// BlogServiceMock is a mock implementation of goshopify.BlogService.
+// Calls to a method whose Func field is nil return zero values.
+type BlogServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.Blog, error)
+	CountFunc  func(interface{}) (int, error)
+	GetFunc    func(int64, interface{}) (*goshopify.Blog, error)
+	CreateFunc func(goshopify.Blog) (*goshopify.Blog, error)
+	UpdateFunc func(goshopify.Blog) (*goshopify.Blog, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *BlogServiceMock) List(a0 interface{}) (r0 []goshopify.Blog, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *BlogServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *BlogServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Blog, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *BlogServiceMock) Create(a0 goshopify.Blog) (r0 *goshopify.Blog, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *BlogServiceMock) Update(a0 goshopify.Blog) (r0 *goshopify.Blog, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *BlogServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// CancellationRequestServiceMock is a mock implementation of goshopify.CancellationRequestService.
+// Calls to a method whose Func field is nil return zero values.
+type CancellationRequestServiceMock struct {
+	Recorder
+
+	SendFunc   func(int64, goshopify.CancellationRequest) (*goshopify.FulfillmentOrder, error)
+	AcceptFunc func(int64, goshopify.CancellationRequest) (*goshopify.FulfillmentOrder, error)
+	RejectFunc func(int64, goshopify.CancellationRequest) (*goshopify.FulfillmentOrder, error)
+}
+
+// Send calls SendFunc and records the call.
+func (m *CancellationRequestServiceMock) Send(a0 int64, a1 goshopify.CancellationRequest) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Send", a0, a1)
+	if m.SendFunc == nil {
+		return
+	}
+	return m.SendFunc(a0, a1)
+}
+
+// Accept calls AcceptFunc and records the call.
+func (m *CancellationRequestServiceMock) Accept(a0 int64, a1 goshopify.CancellationRequest) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Accept", a0, a1)
+	if m.AcceptFunc == nil {
+		return
+	}
+	return m.AcceptFunc(a0, a1)
+}
+
+// Reject calls RejectFunc and records the call.
+func (m *CancellationRequestServiceMock) Reject(a0 int64, a1 goshopify.CancellationRequest) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Reject", a0, a1)
+	if m.RejectFunc == nil {
+		return
+	}
+	return m.RejectFunc(a0, a1)
+}
+
+// CarrierServiceServiceMock is a mock implementation of goshopify.CarrierServiceService.
+// Calls to a method whose Func field is nil return zero values.
+type CarrierServiceServiceMock struct {
+	Recorder
+
+	ListFunc   func() ([]goshopify.CarrierService, error)
+	GetFunc    func(int64) (*goshopify.CarrierService, error)
+	CreateFunc func(goshopify.CarrierService) (*goshopify.CarrierService, error)
+	UpdateFunc func(goshopify.CarrierService) (*goshopify.CarrierService, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *CarrierServiceServiceMock) List() (r0 []goshopify.CarrierService, r1 error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc()
+}
+
+// Get calls GetFunc and records the call.
+func (m *CarrierServiceServiceMock) Get(a0 int64) (r0 *goshopify.CarrierService, r1 error) {
+	m.record("Get", a0)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *CarrierServiceServiceMock) Create(a0 goshopify.CarrierService) (r0 *goshopify.CarrierService, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *CarrierServiceServiceMock) Update(a0 goshopify.CarrierService) (r0 *goshopify.CarrierService, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *CarrierServiceServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// CollectServiceMock is a mock implementation of goshopify.CollectService.
+// Calls to a method whose Func field is nil return zero values.
+type CollectServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.Collect, error)
+	CountFunc  func(interface{}) (int, error)
+	GetFunc    func(int64, interface{}) (*goshopify.Collect, error)
+	CreateFunc func(goshopify.Collect) (*goshopify.Collect, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *CollectServiceMock) List(a0 interface{}) (r0 []goshopify.Collect, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *CollectServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *CollectServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Collect, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *CollectServiceMock) Create(a0 goshopify.Collect) (r0 *goshopify.Collect, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *CollectServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// CollectionListingServiceMock is a mock implementation of goshopify.CollectionListingService.
+// Calls to a method whose Func field is nil return zero values.
+type CollectionListingServiceMock struct {
+	Recorder
+
+	ListFunc               func(interface{}) ([]goshopify.CollectionListing, error)
+	ListWithPaginationFunc func(interface{}) ([]goshopify.CollectionListing, *goshopify.Pagination, error)
+	GetFunc                func(int64, interface{}) (*goshopify.CollectionListing, error)
+	GetProductIDsFunc      func(int64, interface{}) ([]int64, error)
+	PublishFunc            func(int64) (*goshopify.CollectionListing, error)
+	DeleteFunc             func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *CollectionListingServiceMock) List(a0 interface{}) (r0 []goshopify.CollectionListing, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *CollectionListingServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.CollectionListing, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *CollectionListingServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.CollectionListing, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// GetProductIDs calls GetProductIDsFunc and records the call.
+func (m *CollectionListingServiceMock) GetProductIDs(a0 int64, a1 interface{}) (r0 []int64, r1 error) {
+	m.record("GetProductIDs", a0, a1)
+	if m.GetProductIDsFunc == nil {
+		return
+	}
+	return m.GetProductIDsFunc(a0, a1)
+}
+
+// Publish calls PublishFunc and records the call.
+func (m *CollectionListingServiceMock) Publish(a0 int64) (r0 *goshopify.CollectionListing, r1 error) {
+	m.record("Publish", a0)
+	if m.PublishFunc == nil {
+		return
+	}
+	return m.PublishFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *CollectionListingServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// CollectionServiceMock is a mock implementation of goshopify.CollectionService.
+// Calls to a method whose Func field is nil return zero values.
+type CollectionServiceMock struct {
+	Recorder
+
+	GetFunc                        func(int64, interface{}) (*goshopify.Collection, error)
+	ListProductsFunc               func(int64, interface{}) ([]goshopify.Product, error)
+	ListProductsWithPaginationFunc func(int64, interface{}) ([]goshopify.Product, *goshopify.Pagination, error)
+}
+
+// Get calls GetFunc and records the call.
+func (m *CollectionServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Collection, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// ListProducts calls ListProductsFunc and records the call.
+func (m *CollectionServiceMock) ListProducts(a0 int64, a1 interface{}) (r0 []goshopify.Product, r1 error) {
+	m.record("ListProducts", a0, a1)
+	if m.ListProductsFunc == nil {
+		return
+	}
+	return m.ListProductsFunc(a0, a1)
+}
+
+// ListProductsWithPagination calls ListProductsWithPaginationFunc and records the call.
+func (m *CollectionServiceMock) ListProductsWithPagination(a0 int64, a1 interface{}) (r0 []goshopify.Product, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListProductsWithPagination", a0, a1)
+	if m.ListProductsWithPaginationFunc == nil {
+		return
+	}
+	return m.ListProductsWithPaginationFunc(a0, a1)
+}
+
+// CommentServiceMock is a mock implementation of goshopify.CommentService.
+// Calls to a method whose Func field is nil return zero values.
+type CommentServiceMock struct {
+	Recorder
+
+	ListFunc               func(interface{}) ([]goshopify.Comment, error)
+	ListWithPaginationFunc func(interface{}) ([]goshopify.Comment, *goshopify.Pagination, error)
+	CountFunc              func(interface{}) (int, error)
+	GetFunc                func(int64, interface{}) (*goshopify.Comment, error)
+	CreateFunc             func(goshopify.Comment) (*goshopify.Comment, error)
+	UpdateFunc             func(goshopify.Comment) (*goshopify.Comment, error)
+	ApproveFunc            func(int64) (*goshopify.Comment, error)
+	SpamFunc               func(int64) (*goshopify.Comment, error)
+	NotSpamFunc            func(int64) (*goshopify.Comment, error)
+	RemoveFunc             func(int64) (*goshopify.Comment, error)
+	RestoreFunc            func(int64) (*goshopify.Comment, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *CommentServiceMock) List(a0 interface{}) (r0 []goshopify.Comment, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *CommentServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.Comment, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *CommentServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *CommentServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Comment, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *CommentServiceMock) Create(a0 goshopify.Comment) (r0 *goshopify.Comment, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *CommentServiceMock) Update(a0 goshopify.Comment) (r0 *goshopify.Comment, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Approve calls ApproveFunc and records the call.
+func (m *CommentServiceMock) Approve(a0 int64) (r0 *goshopify.Comment, r1 error) {
+	m.record("Approve", a0)
+	if m.ApproveFunc == nil {
+		return
+	}
+	return m.ApproveFunc(a0)
+}
+
+// Spam calls SpamFunc and records the call.
+func (m *CommentServiceMock) Spam(a0 int64) (r0 *goshopify.Comment, r1 error) {
+	m.record("Spam", a0)
+	if m.SpamFunc == nil {
+		return
+	}
+	return m.SpamFunc(a0)
+}
+
+// NotSpam calls NotSpamFunc and records the call.
+func (m *CommentServiceMock) NotSpam(a0 int64) (r0 *goshopify.Comment, r1 error) {
+	m.record("NotSpam", a0)
+	if m.NotSpamFunc == nil {
+		return
+	}
+	return m.NotSpamFunc(a0)
+}
+
+// Remove calls RemoveFunc and records the call.
+func (m *CommentServiceMock) Remove(a0 int64) (r0 *goshopify.Comment, r1 error) {
+	m.record("Remove", a0)
+	if m.RemoveFunc == nil {
+		return
+	}
+	return m.RemoveFunc(a0)
+}
+
+// Restore calls RestoreFunc and records the call.
+func (m *CommentServiceMock) Restore(a0 int64) (r0 *goshopify.Comment, r1 error) {
+	m.record("Restore", a0)
+	if m.RestoreFunc == nil {
+		return
+	}
+	return m.RestoreFunc(a0)
+}
+
+// CountryServiceMock is a mock implementation of goshopify.CountryService.
+// Calls to a method whose Func field is nil return zero values.
+type CountryServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.Country, error)
+	CountFunc  func(interface{}) (int, error)
+	GetFunc    func(int64, interface{}) (*goshopify.Country, error)
+	CreateFunc func(goshopify.Country) (*goshopify.Country, error)
+	UpdateFunc func(goshopify.Country) (*goshopify.Country, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *CountryServiceMock) List(a0 interface{}) (r0 []goshopify.Country, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *CountryServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *CountryServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Country, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *CountryServiceMock) Create(a0 goshopify.Country) (r0 *goshopify.Country, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *CountryServiceMock) Update(a0 goshopify.Country) (r0 *goshopify.Country, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *CountryServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// CustomCollectionServiceMock is a mock implementation of goshopify.CustomCollectionService.
+// Calls to a method whose Func field is nil return zero values.
+type CustomCollectionServiceMock struct {
+	Recorder
+
+	ListFunc            func(interface{}) ([]goshopify.CustomCollection, error)
+	CountFunc           func(interface{}) (int, error)
+	GetFunc             func(int64, interface{}) (*goshopify.CustomCollection, error)
+	CreateFunc          func(goshopify.CustomCollection) (*goshopify.CustomCollection, error)
+	UpdateFunc          func(goshopify.CustomCollection) (*goshopify.CustomCollection, error)
+	DeleteFunc          func(int64) error
+	ListMetafieldsFunc  func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc func(int64, interface{}) (int, error)
+	GetMetafieldFunc    func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *CustomCollectionServiceMock) List(a0 interface{}) (r0 []goshopify.CustomCollection, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *CustomCollectionServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *CustomCollectionServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.CustomCollection, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *CustomCollectionServiceMock) Create(a0 goshopify.CustomCollection) (r0 *goshopify.CustomCollection, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *CustomCollectionServiceMock) Update(a0 goshopify.CustomCollection) (r0 *goshopify.CustomCollection, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *CustomCollectionServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *CustomCollectionServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *CustomCollectionServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *CustomCollectionServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *CustomCollectionServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *CustomCollectionServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *CustomCollectionServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// CustomerAddressServiceMock is a mock implementation of goshopify.CustomerAddressService.
+// Calls to a method whose Func field is nil return zero values.
+type CustomerAddressServiceMock struct {
+	Recorder
+
+	ListFunc   func(int64, interface{}) ([]goshopify.CustomerAddress, error)
+	GetFunc    func(int64, int64, interface{}) (*goshopify.CustomerAddress, error)
+	CreateFunc func(int64, goshopify.CustomerAddress) (*goshopify.CustomerAddress, error)
+	UpdateFunc func(int64, goshopify.CustomerAddress) (*goshopify.CustomerAddress, error)
+	DeleteFunc func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *CustomerAddressServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.CustomerAddress, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *CustomerAddressServiceMock) Get(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.CustomerAddress, r1 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1, a2)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *CustomerAddressServiceMock) Create(a0 int64, a1 goshopify.CustomerAddress) (r0 *goshopify.CustomerAddress, r1 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *CustomerAddressServiceMock) Update(a0 int64, a1 goshopify.CustomerAddress) (r0 *goshopify.CustomerAddress, r1 error) {
+	m.record("Update", a0, a1)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0, a1)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *CustomerAddressServiceMock) Delete(a0 int64, a1 int64) (r0 error) {
+	m.record("Delete", a0, a1)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0, a1)
+}
+
+// CustomerSavedSearchServiceMock is a mock implementation of goshopify.CustomerSavedSearchService.
+// Calls to a method whose Func field is nil return zero values.
+type CustomerSavedSearchServiceMock struct {
+	Recorder
+
+	ListFunc                        func(interface{}) ([]goshopify.CustomerSavedSearch, error)
+	ListWithPaginationFunc          func(interface{}) ([]goshopify.CustomerSavedSearch, *goshopify.Pagination, error)
+	CountFunc                       func(interface{}) (int, error)
+	GetFunc                         func(int64, interface{}) (*goshopify.CustomerSavedSearch, error)
+	CreateFunc                      func(goshopify.CustomerSavedSearch) (*goshopify.CustomerSavedSearch, error)
+	UpdateFunc                      func(goshopify.CustomerSavedSearch) (*goshopify.CustomerSavedSearch, error)
+	DeleteFunc                      func(int64) error
+	ListCustomersFunc               func(int64, interface{}) ([]goshopify.Customer, error)
+	ListCustomersWithPaginationFunc func(int64, interface{}) ([]goshopify.Customer, *goshopify.Pagination, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *CustomerSavedSearchServiceMock) List(a0 interface{}) (r0 []goshopify.CustomerSavedSearch, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *CustomerSavedSearchServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.CustomerSavedSearch, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *CustomerSavedSearchServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *CustomerSavedSearchServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.CustomerSavedSearch, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *CustomerSavedSearchServiceMock) Create(a0 goshopify.CustomerSavedSearch) (r0 *goshopify.CustomerSavedSearch, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *CustomerSavedSearchServiceMock) Update(a0 goshopify.CustomerSavedSearch) (r0 *goshopify.CustomerSavedSearch, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *CustomerSavedSearchServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ListCustomers calls ListCustomersFunc and records the call.
+func (m *CustomerSavedSearchServiceMock) ListCustomers(a0 int64, a1 interface{}) (r0 []goshopify.Customer, r1 error) {
+	m.record("ListCustomers", a0, a1)
+	if m.ListCustomersFunc == nil {
+		return
+	}
+	return m.ListCustomersFunc(a0, a1)
+}
+
+// ListCustomersWithPagination calls ListCustomersWithPaginationFunc and records the call.
+func (m *CustomerSavedSearchServiceMock) ListCustomersWithPagination(a0 int64, a1 interface{}) (r0 []goshopify.Customer, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListCustomersWithPagination", a0, a1)
+	if m.ListCustomersWithPaginationFunc == nil {
+		return
+	}
+	return m.ListCustomersWithPaginationFunc(a0, a1)
+}
+
+// CustomerServiceMock is a mock implementation of goshopify.CustomerService.
+// Calls to a method whose Func field is nil return zero values.
+type CustomerServiceMock struct {
+	Recorder
+
+	ListFunc                    func(interface{}) ([]goshopify.Customer, error)
+	ListWithPaginationFunc      func(interface{}) ([]goshopify.Customer, *goshopify.Pagination, error)
+	CountFunc                   func(interface{}) (int, error)
+	GetFunc                     func(int64, interface{}) (*goshopify.Customer, error)
+	SearchFunc                  func(interface{}) ([]goshopify.Customer, error)
+	CreateFunc                  func(goshopify.Customer) (*goshopify.Customer, error)
+	UpdateFunc                  func(goshopify.Customer) (*goshopify.Customer, error)
+	DeleteFunc                  func(int64) error
+	ListOrdersFunc              func(int64, interface{}) ([]goshopify.Order, error)
+	ListTagsFunc                func(interface{}) ([]string, error)
+	GetAccountActivationURLFunc func(int64) (string, error)
+	SendInviteFunc              func(int64, goshopify.CustomerInvite) (*goshopify.CustomerInvite, error)
+	ListMetafieldsFunc          func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc         func(int64, interface{}) (int, error)
+	GetMetafieldFunc            func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc         func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc         func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc         func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *CustomerServiceMock) List(a0 interface{}) (r0 []goshopify.Customer, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *CustomerServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.Customer, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *CustomerServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *CustomerServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Customer, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Search calls SearchFunc and records the call.
+func (m *CustomerServiceMock) Search(a0 interface{}) (r0 []goshopify.Customer, r1 error) {
+	m.record("Search", a0)
+	if m.SearchFunc == nil {
+		return
+	}
+	return m.SearchFunc(a0)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *CustomerServiceMock) Create(a0 goshopify.Customer) (r0 *goshopify.Customer, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *CustomerServiceMock) Update(a0 goshopify.Customer) (r0 *goshopify.Customer, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *CustomerServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ListOrders calls ListOrdersFunc and records the call.
+func (m *CustomerServiceMock) ListOrders(a0 int64, a1 interface{}) (r0 []goshopify.Order, r1 error) {
+	m.record("ListOrders", a0, a1)
+	if m.ListOrdersFunc == nil {
+		return
+	}
+	return m.ListOrdersFunc(a0, a1)
+}
+
+// ListTags calls ListTagsFunc and records the call.
+func (m *CustomerServiceMock) ListTags(a0 interface{}) (r0 []string, r1 error) {
+	m.record("ListTags", a0)
+	if m.ListTagsFunc == nil {
+		return
+	}
+	return m.ListTagsFunc(a0)
+}
+
+// GetAccountActivationURL calls GetAccountActivationURLFunc and records the call.
+func (m *CustomerServiceMock) GetAccountActivationURL(a0 int64) (r0 string, r1 error) {
+	m.record("GetAccountActivationURL", a0)
+	if m.GetAccountActivationURLFunc == nil {
+		return
+	}
+	return m.GetAccountActivationURLFunc(a0)
+}
+
+// SendInvite calls SendInviteFunc and records the call.
+func (m *CustomerServiceMock) SendInvite(a0 int64, a1 goshopify.CustomerInvite) (r0 *goshopify.CustomerInvite, r1 error) {
+	m.record("SendInvite", a0, a1)
+	if m.SendInviteFunc == nil {
+		return
+	}
+	return m.SendInviteFunc(a0, a1)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *CustomerServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *CustomerServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *CustomerServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *CustomerServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *CustomerServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *CustomerServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// DiscountCodeServiceMock is a mock implementation of goshopify.DiscountCodeService.
+// Calls to a method whose Func field is nil return zero values.
+type DiscountCodeServiceMock struct {
+	Recorder
+
+	CreateFunc func(int64, goshopify.PriceRuleDiscountCode) (*goshopify.PriceRuleDiscountCode, error)
+	UpdateFunc func(int64, goshopify.PriceRuleDiscountCode) (*goshopify.PriceRuleDiscountCode, error)
+	ListFunc   func(int64) ([]goshopify.PriceRuleDiscountCode, error)
+	GetFunc    func(int64, int64) (*goshopify.PriceRuleDiscountCode, error)
+	DeleteFunc func(int64, int64) error
+}
+
+// Create calls CreateFunc and records the call.
+func (m *DiscountCodeServiceMock) Create(a0 int64, a1 goshopify.PriceRuleDiscountCode) (r0 *goshopify.PriceRuleDiscountCode, r1 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *DiscountCodeServiceMock) Update(a0 int64, a1 goshopify.PriceRuleDiscountCode) (r0 *goshopify.PriceRuleDiscountCode, r1 error) {
+	m.record("Update", a0, a1)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0, a1)
+}
+
+// List calls ListFunc and records the call.
+func (m *DiscountCodeServiceMock) List(a0 int64) (r0 []goshopify.PriceRuleDiscountCode, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *DiscountCodeServiceMock) Get(a0 int64, a1 int64) (r0 *goshopify.PriceRuleDiscountCode, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *DiscountCodeServiceMock) Delete(a0 int64, a1 int64) (r0 error) {
+	m.record("Delete", a0, a1)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0, a1)
+}
+
+// DisputeServiceMock is a mock implementation of goshopify.DisputeService.
+// Calls to a method whose Func field is nil return zero values.
+type DisputeServiceMock struct {
+	Recorder
+
+	ListFunc               func(interface{}) ([]goshopify.Dispute, error)
+	ListWithPaginationFunc func(interface{}) ([]goshopify.Dispute, *goshopify.Pagination, error)
+	GetFunc                func(int64, interface{}) (*goshopify.Dispute, error)
+	GetEvidenceFunc        func(int64) (*goshopify.DisputeEvidence, error)
+	UpdateEvidenceFunc     func(int64, goshopify.DisputeEvidence) (*goshopify.DisputeEvidence, error)
+	UploadFileFunc         func(int64, goshopify.DisputeFileUploadRequest) (*goshopify.DisputeFileUpload, error)
+	DeleteFileFunc         func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *DisputeServiceMock) List(a0 interface{}) (r0 []goshopify.Dispute, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *DisputeServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.Dispute, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *DisputeServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Dispute, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// GetEvidence calls GetEvidenceFunc and records the call.
+func (m *DisputeServiceMock) GetEvidence(a0 int64) (r0 *goshopify.DisputeEvidence, r1 error) {
+	m.record("GetEvidence", a0)
+	if m.GetEvidenceFunc == nil {
+		return
+	}
+	return m.GetEvidenceFunc(a0)
+}
+
+// UpdateEvidence calls UpdateEvidenceFunc and records the call.
+func (m *DisputeServiceMock) UpdateEvidence(a0 int64, a1 goshopify.DisputeEvidence) (r0 *goshopify.DisputeEvidence, r1 error) {
+	m.record("UpdateEvidence", a0, a1)
+	if m.UpdateEvidenceFunc == nil {
+		return
+	}
+	return m.UpdateEvidenceFunc(a0, a1)
+}
+
+// UploadFile calls UploadFileFunc and records the call.
+func (m *DisputeServiceMock) UploadFile(a0 int64, a1 goshopify.DisputeFileUploadRequest) (r0 *goshopify.DisputeFileUpload, r1 error) {
+	m.record("UploadFile", a0, a1)
+	if m.UploadFileFunc == nil {
+		return
+	}
+	return m.UploadFileFunc(a0, a1)
+}
+
+// DeleteFile calls DeleteFileFunc and records the call.
+func (m *DisputeServiceMock) DeleteFile(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteFile", a0, a1)
+	if m.DeleteFileFunc == nil {
+		return
+	}
+	return m.DeleteFileFunc(a0, a1)
+}
+
+// DraftOrderServiceMock is a mock implementation of goshopify.DraftOrderService.
+// Calls to a method whose Func field is nil return zero values.
+type DraftOrderServiceMock struct {
+	Recorder
+
+	ListFunc            func(interface{}) ([]goshopify.DraftOrder, error)
+	CountFunc           func(interface{}) (int, error)
+	GetFunc             func(int64, interface{}) (*goshopify.DraftOrder, error)
+	CreateFunc          func(goshopify.DraftOrder) (*goshopify.DraftOrder, error)
+	UpdateFunc          func(goshopify.DraftOrder) (*goshopify.DraftOrder, error)
+	DeleteFunc          func(int64) error
+	InvoiceFunc         func(int64, goshopify.DraftOrderInvoice) (*goshopify.DraftOrderInvoice, error)
+	CompleteFunc        func(int64, bool) (*goshopify.DraftOrder, error)
+	ListMetafieldsFunc  func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc func(int64, interface{}) (int, error)
+	GetMetafieldFunc    func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *DraftOrderServiceMock) List(a0 interface{}) (r0 []goshopify.DraftOrder, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *DraftOrderServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *DraftOrderServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.DraftOrder, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *DraftOrderServiceMock) Create(a0 goshopify.DraftOrder) (r0 *goshopify.DraftOrder, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *DraftOrderServiceMock) Update(a0 goshopify.DraftOrder) (r0 *goshopify.DraftOrder, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *DraftOrderServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// Invoice calls InvoiceFunc and records the call.
+func (m *DraftOrderServiceMock) Invoice(a0 int64, a1 goshopify.DraftOrderInvoice) (r0 *goshopify.DraftOrderInvoice, r1 error) {
+	m.record("Invoice", a0, a1)
+	if m.InvoiceFunc == nil {
+		return
+	}
+	return m.InvoiceFunc(a0, a1)
+}
+
+// Complete calls CompleteFunc and records the call.
+func (m *DraftOrderServiceMock) Complete(a0 int64, a1 bool) (r0 *goshopify.DraftOrder, r1 error) {
+	m.record("Complete", a0, a1)
+	if m.CompleteFunc == nil {
+		return
+	}
+	return m.CompleteFunc(a0, a1)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *DraftOrderServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *DraftOrderServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *DraftOrderServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *DraftOrderServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *DraftOrderServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *DraftOrderServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// EventServiceMock is a mock implementation of goshopify.EventService.
+// Calls to a method whose Func field is nil return zero values.
+type EventServiceMock struct {
+	Recorder
+
+	ListFunc               func(interface{}) ([]goshopify.Event, error)
+	ListWithPaginationFunc func(interface{}) ([]goshopify.Event, *goshopify.Pagination, error)
+	CountFunc              func(interface{}) (int, error)
+	GetFunc                func(int64, interface{}) (*goshopify.Event, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *EventServiceMock) List(a0 interface{}) (r0 []goshopify.Event, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *EventServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.Event, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *EventServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *EventServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Event, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// EventsServiceMock is a mock implementation of goshopify.EventsService.
+// Calls to a method whose Func field is nil return zero values.
+type EventsServiceMock struct {
+	Recorder
+
+	ListEventsFunc               func(int64, interface{}) ([]goshopify.Event, error)
+	ListEventsWithPaginationFunc func(int64, interface{}) ([]goshopify.Event, *goshopify.Pagination, error)
+}
+
+// ListEvents calls ListEventsFunc and records the call.
+func (m *EventsServiceMock) ListEvents(a0 int64, a1 interface{}) (r0 []goshopify.Event, r1 error) {
+	m.record("ListEvents", a0, a1)
+	if m.ListEventsFunc == nil {
+		return
+	}
+	return m.ListEventsFunc(a0, a1)
+}
+
+// ListEventsWithPagination calls ListEventsWithPaginationFunc and records the call.
+func (m *EventsServiceMock) ListEventsWithPagination(a0 int64, a1 interface{}) (r0 []goshopify.Event, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListEventsWithPagination", a0, a1)
+	if m.ListEventsWithPaginationFunc == nil {
+		return
+	}
+	return m.ListEventsWithPaginationFunc(a0, a1)
+}
+
+// FulfillmentEventServiceMock is a mock implementation of goshopify.FulfillmentEventService.
+// Calls to a method whose Func field is nil return zero values.
+type FulfillmentEventServiceMock struct {
+	Recorder
+
+	ListFunc   func(int64, int64) ([]goshopify.FulfillmentEvent, error)
+	GetFunc    func(int64, int64, int64) (*goshopify.FulfillmentEvent, error)
+	CreateFunc func(int64, int64, goshopify.FulfillmentEvent) (*goshopify.FulfillmentEvent, error)
+	DeleteFunc func(int64, int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *FulfillmentEventServiceMock) List(a0 int64, a1 int64) (r0 []goshopify.FulfillmentEvent, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *FulfillmentEventServiceMock) Get(a0 int64, a1 int64, a2 int64) (r0 *goshopify.FulfillmentEvent, r1 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1, a2)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *FulfillmentEventServiceMock) Create(a0 int64, a1 int64, a2 goshopify.FulfillmentEvent) (r0 *goshopify.FulfillmentEvent, r1 error) {
+	m.record("Create", a0, a1, a2)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1, a2)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *FulfillmentEventServiceMock) Delete(a0 int64, a1 int64, a2 int64) (r0 error) {
+	m.record("Delete", a0, a1, a2)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0, a1, a2)
+}
+
+// FulfillmentOrderServiceMock is a mock implementation of goshopify.FulfillmentOrderService.
+// Calls to a method whose Func field is nil return zero values.
+type FulfillmentOrderServiceMock struct {
+	Recorder
+
+	ListFunc             func(int64, interface{}) ([]goshopify.FulfillmentOrder, error)
+	GetFunc              func(int64, interface{}) (*goshopify.FulfillmentOrder, error)
+	CancelFunc           func(int64) (*goshopify.FulfillmentOrder, error)
+	CloseFunc            func(int64, string) (*goshopify.FulfillmentOrder, error)
+	HoldFunc             func(int64, bool, goshopify.FulfillmentOrderHoldReason, string) (*goshopify.FulfillmentOrder, error)
+	OpenFunc             func(int64) (*goshopify.FulfillmentOrder, error)
+	ReleaseHoldFunc      func(int64) (*goshopify.FulfillmentOrder, error)
+	RescheduleFunc       func(int64) (*goshopify.FulfillmentOrder, error)
+	SetDeadlineFunc      func([]int64, time.Time) error
+	MoveFunc             func(int64, goshopify.FulfillmentOrderMoveRequest) (*goshopify.FulfillmentOrderMoveResource, error)
+	LocationsForMoveFunc func(int64) ([]goshopify.FulfillmentOrderLocationForMove, error)
+	SplitFunc            func(int64, []goshopify.FulfillmentOrderLineItemQuantity) (*goshopify.FulfillmentOrderSplitResult, error)
+	MergeFunc            func([]goshopify.FulfillmentOrderMergeIntent) (*goshopify.FulfillmentOrder, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *FulfillmentOrderServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.FulfillmentOrder, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *FulfillmentOrderServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Cancel calls CancelFunc and records the call.
+func (m *FulfillmentOrderServiceMock) Cancel(a0 int64) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Cancel", a0)
+	if m.CancelFunc == nil {
+		return
+	}
+	return m.CancelFunc(a0)
+}
+
+// Close calls CloseFunc and records the call.
+func (m *FulfillmentOrderServiceMock) Close(a0 int64, a1 string) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Close", a0, a1)
+	if m.CloseFunc == nil {
+		return
+	}
+	return m.CloseFunc(a0, a1)
+}
+
+// Hold calls HoldFunc and records the call.
+func (m *FulfillmentOrderServiceMock) Hold(a0 int64, a1 bool, a2 goshopify.FulfillmentOrderHoldReason, a3 string) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Hold", a0, a1, a2, a3)
+	if m.HoldFunc == nil {
+		return
+	}
+	return m.HoldFunc(a0, a1, a2, a3)
+}
+
+// Open calls OpenFunc and records the call.
+func (m *FulfillmentOrderServiceMock) Open(a0 int64) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Open", a0)
+	if m.OpenFunc == nil {
+		return
+	}
+	return m.OpenFunc(a0)
+}
+
+// ReleaseHold calls ReleaseHoldFunc and records the call.
+func (m *FulfillmentOrderServiceMock) ReleaseHold(a0 int64) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("ReleaseHold", a0)
+	if m.ReleaseHoldFunc == nil {
+		return
+	}
+	return m.ReleaseHoldFunc(a0)
+}
+
+// Reschedule calls RescheduleFunc and records the call.
+func (m *FulfillmentOrderServiceMock) Reschedule(a0 int64) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Reschedule", a0)
+	if m.RescheduleFunc == nil {
+		return
+	}
+	return m.RescheduleFunc(a0)
+}
+
+// SetDeadline calls SetDeadlineFunc and records the call.
+func (m *FulfillmentOrderServiceMock) SetDeadline(a0 []int64, a1 time.Time) (r0 error) {
+	m.record("SetDeadline", a0, a1)
+	if m.SetDeadlineFunc == nil {
+		return
+	}
+	return m.SetDeadlineFunc(a0, a1)
+}
+
+// Move calls MoveFunc and records the call.
+func (m *FulfillmentOrderServiceMock) Move(a0 int64, a1 goshopify.FulfillmentOrderMoveRequest) (r0 *goshopify.FulfillmentOrderMoveResource, r1 error) {
+	m.record("Move", a0, a1)
+	if m.MoveFunc == nil {
+		return
+	}
+	return m.MoveFunc(a0, a1)
+}
+
+// LocationsForMove calls LocationsForMoveFunc and records the call.
+func (m *FulfillmentOrderServiceMock) LocationsForMove(a0 int64) (r0 []goshopify.FulfillmentOrderLocationForMove, r1 error) {
+	m.record("LocationsForMove", a0)
+	if m.LocationsForMoveFunc == nil {
+		return
+	}
+	return m.LocationsForMoveFunc(a0)
+}
+
+// Split calls SplitFunc and records the call.
+func (m *FulfillmentOrderServiceMock) Split(a0 int64, a1 []goshopify.FulfillmentOrderLineItemQuantity) (r0 *goshopify.FulfillmentOrderSplitResult, r1 error) {
+	m.record("Split", a0, a1)
+	if m.SplitFunc == nil {
+		return
+	}
+	return m.SplitFunc(a0, a1)
+}
+
+// Merge calls MergeFunc and records the call.
+func (m *FulfillmentOrderServiceMock) Merge(a0 []goshopify.FulfillmentOrderMergeIntent) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Merge", a0)
+	if m.MergeFunc == nil {
+		return
+	}
+	return m.MergeFunc(a0)
+}
+
+// FulfillmentRequestServiceMock is a mock implementation of goshopify.FulfillmentRequestService.
+// Calls to a method whose Func field is nil return zero values.
+type FulfillmentRequestServiceMock struct {
+	Recorder
+
+	SendFunc   func(int64, goshopify.FulfillmentRequest) (*goshopify.FulfillmentOrder, error)
+	AcceptFunc func(int64, goshopify.FulfillmentRequest) (*goshopify.FulfillmentOrder, error)
+	RejectFunc func(int64, goshopify.FulfillmentRequest) (*goshopify.FulfillmentOrder, error)
+}
+
+// Send calls SendFunc and records the call.
+func (m *FulfillmentRequestServiceMock) Send(a0 int64, a1 goshopify.FulfillmentRequest) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Send", a0, a1)
+	if m.SendFunc == nil {
+		return
+	}
+	return m.SendFunc(a0, a1)
+}
+
+// Accept calls AcceptFunc and records the call.
+func (m *FulfillmentRequestServiceMock) Accept(a0 int64, a1 goshopify.FulfillmentRequest) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Accept", a0, a1)
+	if m.AcceptFunc == nil {
+		return
+	}
+	return m.AcceptFunc(a0, a1)
+}
+
+// Reject calls RejectFunc and records the call.
+func (m *FulfillmentRequestServiceMock) Reject(a0 int64, a1 goshopify.FulfillmentRequest) (r0 *goshopify.FulfillmentOrder, r1 error) {
+	m.record("Reject", a0, a1)
+	if m.RejectFunc == nil {
+		return
+	}
+	return m.RejectFunc(a0, a1)
+}
+
+// FulfillmentServiceMock is a mock implementation of goshopify.FulfillmentService.
+// Calls to a method whose Func field is nil return zero values.
+type FulfillmentServiceMock struct {
+	Recorder
+
+	ListFunc       func(interface{}) ([]goshopify.Fulfillment, error)
+	CountFunc      func(interface{}) (int, error)
+	GetFunc        func(int64, interface{}) (*goshopify.Fulfillment, error)
+	CreateFunc     func(goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+	UpdateFunc     func(goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+	CompleteFunc   func(int64) (*goshopify.Fulfillment, error)
+	TransitionFunc func(int64) (*goshopify.Fulfillment, error)
+	CancelFunc     func(int64) (*goshopify.Fulfillment, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *FulfillmentServiceMock) List(a0 interface{}) (r0 []goshopify.Fulfillment, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *FulfillmentServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *FulfillmentServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *FulfillmentServiceMock) Create(a0 goshopify.Fulfillment) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *FulfillmentServiceMock) Update(a0 goshopify.Fulfillment) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Complete calls CompleteFunc and records the call.
+func (m *FulfillmentServiceMock) Complete(a0 int64) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("Complete", a0)
+	if m.CompleteFunc == nil {
+		return
+	}
+	return m.CompleteFunc(a0)
+}
+
+// Transition calls TransitionFunc and records the call.
+func (m *FulfillmentServiceMock) Transition(a0 int64) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("Transition", a0)
+	if m.TransitionFunc == nil {
+		return
+	}
+	return m.TransitionFunc(a0)
+}
+
+// Cancel calls CancelFunc and records the call.
+func (m *FulfillmentServiceMock) Cancel(a0 int64) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("Cancel", a0)
+	if m.CancelFunc == nil {
+		return
+	}
+	return m.CancelFunc(a0)
+}
+
+// FulfillmentServiceServiceMock is a mock implementation of goshopify.FulfillmentServiceService.
+// Calls to a method whose Func field is nil return zero values.
+type FulfillmentServiceServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.FulfillmentServiceData, error)
+	GetFunc    func(int64, interface{}) (*goshopify.FulfillmentServiceData, error)
+	CreateFunc func(goshopify.FulfillmentServiceData) (*goshopify.FulfillmentServiceData, error)
+	UpdateFunc func(goshopify.FulfillmentServiceData) (*goshopify.FulfillmentServiceData, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *FulfillmentServiceServiceMock) List(a0 interface{}) (r0 []goshopify.FulfillmentServiceData, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *FulfillmentServiceServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.FulfillmentServiceData, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *FulfillmentServiceServiceMock) Create(a0 goshopify.FulfillmentServiceData) (r0 *goshopify.FulfillmentServiceData, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *FulfillmentServiceServiceMock) Update(a0 goshopify.FulfillmentServiceData) (r0 *goshopify.FulfillmentServiceData, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *FulfillmentServiceServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// FulfillmentsServiceMock is a mock implementation of goshopify.FulfillmentsService.
+// Calls to a method whose Func field is nil return zero values.
+type FulfillmentsServiceMock struct {
+	Recorder
+
+	ListFulfillmentsFunc      func(int64, interface{}) ([]goshopify.Fulfillment, error)
+	CountFulfillmentsFunc     func(int64, interface{}) (int, error)
+	GetFulfillmentFunc        func(int64, int64, interface{}) (*goshopify.Fulfillment, error)
+	CreateFulfillmentFunc     func(int64, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+	UpdateFulfillmentFunc     func(int64, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+	CompleteFulfillmentFunc   func(int64, int64) (*goshopify.Fulfillment, error)
+	TransitionFulfillmentFunc func(int64, int64) (*goshopify.Fulfillment, error)
+	CancelFulfillmentFunc     func(int64, int64) (*goshopify.Fulfillment, error)
+}
+
+// ListFulfillments calls ListFulfillmentsFunc and records the call.
+func (m *FulfillmentsServiceMock) ListFulfillments(a0 int64, a1 interface{}) (r0 []goshopify.Fulfillment, r1 error) {
+	m.record("ListFulfillments", a0, a1)
+	if m.ListFulfillmentsFunc == nil {
+		return
+	}
+	return m.ListFulfillmentsFunc(a0, a1)
+}
+
+// CountFulfillments calls CountFulfillmentsFunc and records the call.
+func (m *FulfillmentsServiceMock) CountFulfillments(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountFulfillments", a0, a1)
+	if m.CountFulfillmentsFunc == nil {
+		return
+	}
+	return m.CountFulfillmentsFunc(a0, a1)
+}
+
+// GetFulfillment calls GetFulfillmentFunc and records the call.
+func (m *FulfillmentsServiceMock) GetFulfillment(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("GetFulfillment", a0, a1, a2)
+	if m.GetFulfillmentFunc == nil {
+		return
+	}
+	return m.GetFulfillmentFunc(a0, a1, a2)
+}
+
+// CreateFulfillment calls CreateFulfillmentFunc and records the call.
+func (m *FulfillmentsServiceMock) CreateFulfillment(a0 int64, a1 goshopify.Fulfillment) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("CreateFulfillment", a0, a1)
+	if m.CreateFulfillmentFunc == nil {
+		return
+	}
+	return m.CreateFulfillmentFunc(a0, a1)
+}
+
+// UpdateFulfillment calls UpdateFulfillmentFunc and records the call.
+func (m *FulfillmentsServiceMock) UpdateFulfillment(a0 int64, a1 goshopify.Fulfillment) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("UpdateFulfillment", a0, a1)
+	if m.UpdateFulfillmentFunc == nil {
+		return
+	}
+	return m.UpdateFulfillmentFunc(a0, a1)
+}
+
+// CompleteFulfillment calls CompleteFulfillmentFunc and records the call.
+func (m *FulfillmentsServiceMock) CompleteFulfillment(a0 int64, a1 int64) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("CompleteFulfillment", a0, a1)
+	if m.CompleteFulfillmentFunc == nil {
+		return
+	}
+	return m.CompleteFulfillmentFunc(a0, a1)
+}
+
+// TransitionFulfillment calls TransitionFulfillmentFunc and records the call.
+func (m *FulfillmentsServiceMock) TransitionFulfillment(a0 int64, a1 int64) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("TransitionFulfillment", a0, a1)
+	if m.TransitionFulfillmentFunc == nil {
+		return
+	}
+	return m.TransitionFulfillmentFunc(a0, a1)
+}
+
+// CancelFulfillment calls CancelFulfillmentFunc and records the call.
+func (m *FulfillmentsServiceMock) CancelFulfillment(a0 int64, a1 int64) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("CancelFulfillment", a0, a1)
+	if m.CancelFulfillmentFunc == nil {
+		return
+	}
+	return m.CancelFulfillmentFunc(a0, a1)
+}
+
+// GiftCardServiceMock is a mock implementation of goshopify.GiftCardService.
+// Calls to a method whose Func field is nil return zero values.
+type GiftCardServiceMock struct {
+	Recorder
+
+	GetFunc     func(int64) (*goshopify.GiftCard, error)
+	CreateFunc  func(goshopify.GiftCard) (*goshopify.GiftCard, error)
+	UpdateFunc  func(goshopify.GiftCard) (*goshopify.GiftCard, error)
+	ListFunc    func() ([]goshopify.GiftCard, error)
+	DisableFunc func(int64) (*goshopify.GiftCard, error)
+	CountFunc   func(interface{}) (int, error)
+}
+
+// Get calls GetFunc and records the call.
+func (m *GiftCardServiceMock) Get(a0 int64) (r0 *goshopify.GiftCard, r1 error) {
+	m.record("Get", a0)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *GiftCardServiceMock) Create(a0 goshopify.GiftCard) (r0 *goshopify.GiftCard, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *GiftCardServiceMock) Update(a0 goshopify.GiftCard) (r0 *goshopify.GiftCard, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// List calls ListFunc and records the call.
+func (m *GiftCardServiceMock) List() (r0 []goshopify.GiftCard, r1 error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc()
+}
+
+// Disable calls DisableFunc and records the call.
+func (m *GiftCardServiceMock) Disable(a0 int64) (r0 *goshopify.GiftCard, r1 error) {
+	m.record("Disable", a0)
+	if m.DisableFunc == nil {
+		return
+	}
+	return m.DisableFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *GiftCardServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// GraphQLServiceMock is a mock implementation of goshopify.GraphQLService.
+// Calls to a method whose Func field is nil return zero values.
+type GraphQLServiceMock struct {
+	Recorder
+
+	QueryFunc func(string, interface{}, interface{}) error
+}
+
+// Query calls QueryFunc and records the call.
+func (m *GraphQLServiceMock) Query(a0 string, a1 interface{}, a2 interface{}) (r0 error) {
+	m.record("Query", a0, a1, a2)
+	if m.QueryFunc == nil {
+		return
+	}
+	return m.QueryFunc(a0, a1, a2)
+}
+
+// ImageServiceMock is a mock implementation of goshopify.ImageService.
+// Calls to a method whose Func field is nil return zero values.
+type ImageServiceMock struct {
+	Recorder
+
+	ListFunc   func(int64, interface{}) ([]goshopify.Image, error)
+	CountFunc  func(int64, interface{}) (int, error)
+	GetFunc    func(int64, int64, interface{}) (*goshopify.Image, error)
+	CreateFunc func(int64, goshopify.Image) (*goshopify.Image, error)
+	UpdateFunc func(int64, goshopify.Image) (*goshopify.Image, error)
+	DeleteFunc func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *ImageServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.Image, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// Count calls CountFunc and records the call.
+func (m *ImageServiceMock) Count(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0, a1)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *ImageServiceMock) Get(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Image, r1 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1, a2)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *ImageServiceMock) Create(a0 int64, a1 goshopify.Image) (r0 *goshopify.Image, r1 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *ImageServiceMock) Update(a0 int64, a1 goshopify.Image) (r0 *goshopify.Image, r1 error) {
+	m.record("Update", a0, a1)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0, a1)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *ImageServiceMock) Delete(a0 int64, a1 int64) (r0 error) {
+	m.record("Delete", a0, a1)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0, a1)
+}
+
+// InventoryItemServiceMock is a mock implementation of goshopify.InventoryItemService.
+// Calls to a method whose Func field is nil return zero values.
+type InventoryItemServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.InventoryItem, error)
+	GetFunc    func(int64, interface{}) (*goshopify.InventoryItem, error)
+	UpdateFunc func(goshopify.InventoryItem) (*goshopify.InventoryItem, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *InventoryItemServiceMock) List(a0 interface{}) (r0 []goshopify.InventoryItem, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *InventoryItemServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.InventoryItem, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *InventoryItemServiceMock) Update(a0 goshopify.InventoryItem) (r0 *goshopify.InventoryItem, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// InventoryLevelServiceMock is a mock implementation of goshopify.InventoryLevelService.
+// Calls to a method whose Func field is nil return zero values.
+type InventoryLevelServiceMock struct {
+	Recorder
+
+	ListFunc    func(interface{}) ([]goshopify.InventoryLevel, error)
+	AdjustFunc  func(interface{}) (*goshopify.InventoryLevel, error)
+	DeleteFunc  func(int64, int64) error
+	ConnectFunc func(goshopify.InventoryLevel) (*goshopify.InventoryLevel, error)
+	SetFunc     func(goshopify.InventoryLevel) (*goshopify.InventoryLevel, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *InventoryLevelServiceMock) List(a0 interface{}) (r0 []goshopify.InventoryLevel, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Adjust calls AdjustFunc and records the call.
+func (m *InventoryLevelServiceMock) Adjust(a0 interface{}) (r0 *goshopify.InventoryLevel, r1 error) {
+	m.record("Adjust", a0)
+	if m.AdjustFunc == nil {
+		return
+	}
+	return m.AdjustFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *InventoryLevelServiceMock) Delete(a0 int64, a1 int64) (r0 error) {
+	m.record("Delete", a0, a1)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0, a1)
+}
+
+// Connect calls ConnectFunc and records the call.
+func (m *InventoryLevelServiceMock) Connect(a0 goshopify.InventoryLevel) (r0 *goshopify.InventoryLevel, r1 error) {
+	m.record("Connect", a0)
+	if m.ConnectFunc == nil {
+		return
+	}
+	return m.ConnectFunc(a0)
+}
+
+// Set calls SetFunc and records the call.
+func (m *InventoryLevelServiceMock) Set(a0 goshopify.InventoryLevel) (r0 *goshopify.InventoryLevel, r1 error) {
+	m.record("Set", a0)
+	if m.SetFunc == nil {
+		return
+	}
+	return m.SetFunc(a0)
+}
+
+// LocationServiceMock is a mock implementation of goshopify.LocationService.
+// Calls to a method whose Func field is nil return zero values.
+type LocationServiceMock struct {
+	Recorder
+
+	ListFunc  func(interface{}) ([]goshopify.Location, error)
+	GetFunc   func(int64, interface{}) (*goshopify.Location, error)
+	CountFunc func(interface{}) (int, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *LocationServiceMock) List(a0 interface{}) (r0 []goshopify.Location, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *LocationServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Location, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Count calls CountFunc and records the call.
+func (m *LocationServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// MetafieldServiceMock is a mock implementation of goshopify.MetafieldService.
+// Calls to a method whose Func field is nil return zero values.
+type MetafieldServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.Metafield, error)
+	CountFunc  func(interface{}) (int, error)
+	GetFunc    func(int64, interface{}) (*goshopify.Metafield, error)
+	CreateFunc func(goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateFunc func(goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *MetafieldServiceMock) List(a0 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *MetafieldServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *MetafieldServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *MetafieldServiceMock) Create(a0 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *MetafieldServiceMock) Update(a0 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *MetafieldServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// MetafieldsServiceMock is a mock implementation of goshopify.MetafieldsService.
+// Calls to a method whose Func field is nil return zero values.
+type MetafieldsServiceMock struct {
+	Recorder
+
+	ListMetafieldsFunc  func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc func(int64, interface{}) (int, error)
+	GetMetafieldFunc    func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc func(int64, int64) error
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *MetafieldsServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *MetafieldsServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *MetafieldsServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *MetafieldsServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *MetafieldsServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *MetafieldsServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// OrderEditServiceMock is a mock implementation of goshopify.OrderEditService.
+// Calls to a method whose Func field is nil return zero values.
+type OrderEditServiceMock struct {
+	Recorder
+
+	BeginFunc               func(int64) (*goshopify.CalculatedOrder, error)
+	AddVariantFunc          func(string, goshopify.OrderEditVariant) (*goshopify.CalculatedOrder, error)
+	SetQuantityFunc         func(string, string, int, bool) (*goshopify.CalculatedOrder, error)
+	AddLineItemDiscountFunc func(string, string, goshopify.OrderEditDiscount) (*goshopify.CalculatedOrder, error)
+	CommitFunc              func(string, goshopify.OrderEditCommitOptions) (*goshopify.Order, error)
+}
+
+// Begin calls BeginFunc and records the call.
+func (m *OrderEditServiceMock) Begin(a0 int64) (r0 *goshopify.CalculatedOrder, r1 error) {
+	m.record("Begin", a0)
+	if m.BeginFunc == nil {
+		return
+	}
+	return m.BeginFunc(a0)
+}
+
+// AddVariant calls AddVariantFunc and records the call.
+func (m *OrderEditServiceMock) AddVariant(a0 string, a1 goshopify.OrderEditVariant) (r0 *goshopify.CalculatedOrder, r1 error) {
+	m.record("AddVariant", a0, a1)
+	if m.AddVariantFunc == nil {
+		return
+	}
+	return m.AddVariantFunc(a0, a1)
+}
+
+// SetQuantity calls SetQuantityFunc and records the call.
+func (m *OrderEditServiceMock) SetQuantity(a0 string, a1 string, a2 int, a3 bool) (r0 *goshopify.CalculatedOrder, r1 error) {
+	m.record("SetQuantity", a0, a1, a2, a3)
+	if m.SetQuantityFunc == nil {
+		return
+	}
+	return m.SetQuantityFunc(a0, a1, a2, a3)
+}
+
+// AddLineItemDiscount calls AddLineItemDiscountFunc and records the call.
+func (m *OrderEditServiceMock) AddLineItemDiscount(a0 string, a1 string, a2 goshopify.OrderEditDiscount) (r0 *goshopify.CalculatedOrder, r1 error) {
+	m.record("AddLineItemDiscount", a0, a1, a2)
+	if m.AddLineItemDiscountFunc == nil {
+		return
+	}
+	return m.AddLineItemDiscountFunc(a0, a1, a2)
+}
+
+// Commit calls CommitFunc and records the call.
+func (m *OrderEditServiceMock) Commit(a0 string, a1 goshopify.OrderEditCommitOptions) (r0 *goshopify.Order, r1 error) {
+	m.record("Commit", a0, a1)
+	if m.CommitFunc == nil {
+		return
+	}
+	return m.CommitFunc(a0, a1)
+}
+
+// OrderRiskServiceMock is a mock implementation of goshopify.OrderRiskService.
+// Calls to a method whose Func field is nil return zero values.
+type OrderRiskServiceMock struct {
+	Recorder
+
+	ListFunc               func(int64, interface{}) ([]goshopify.OrderRisk, error)
+	ListWithPaginationFunc func(int64, interface{}) ([]goshopify.OrderRisk, *goshopify.Pagination, error)
+	GetFunc                func(int64, int64, interface{}) (*goshopify.OrderRisk, error)
+	CreateFunc             func(int64, goshopify.OrderRisk) (*goshopify.OrderRisk, error)
+	UpdateFunc             func(int64, int64, goshopify.OrderRisk) (*goshopify.OrderRisk, error)
+	DeleteFunc             func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *OrderRiskServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.OrderRisk, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *OrderRiskServiceMock) ListWithPagination(a0 int64, a1 interface{}) (r0 []goshopify.OrderRisk, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0, a1)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *OrderRiskServiceMock) Get(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.OrderRisk, r1 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1, a2)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *OrderRiskServiceMock) Create(a0 int64, a1 goshopify.OrderRisk) (r0 *goshopify.OrderRisk, r1 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *OrderRiskServiceMock) Update(a0 int64, a1 int64, a2 goshopify.OrderRisk) (r0 *goshopify.OrderRisk, r1 error) {
+	m.record("Update", a0, a1, a2)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0, a1, a2)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *OrderRiskServiceMock) Delete(a0 int64, a1 int64) (r0 error) {
+	m.record("Delete", a0, a1)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0, a1)
+}
+
+// OrderServiceMock is a mock implementation of goshopify.OrderService.
+// Calls to a method whose Func field is nil return zero values.
+type OrderServiceMock struct {
+	Recorder
+
+	ListFunc                     func(interface{}) ([]goshopify.Order, error)
+	ListWithPaginationFunc       func(interface{}) ([]goshopify.Order, *goshopify.Pagination, error)
+	CountFunc                    func(interface{}) (int, error)
+	GetFunc                      func(int64, interface{}) (*goshopify.Order, error)
+	CreateFunc                   func(goshopify.Order) (*goshopify.Order, error)
+	UpdateFunc                   func(goshopify.Order) (*goshopify.Order, error)
+	CancelFunc                   func(int64, interface{}) (*goshopify.Order, error)
+	CloseFunc                    func(int64) (*goshopify.Order, error)
+	OpenFunc                     func(int64) (*goshopify.Order, error)
+	DeleteFunc                   func(int64) error
+	ListMetafieldsFunc           func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc          func(int64, interface{}) (int, error)
+	GetMetafieldFunc             func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc          func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc          func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc          func(int64, int64) error
+	ListEventsFunc               func(int64, interface{}) ([]goshopify.Event, error)
+	ListEventsWithPaginationFunc func(int64, interface{}) ([]goshopify.Event, *goshopify.Pagination, error)
+	ListFulfillmentsFunc         func(int64, interface{}) ([]goshopify.Fulfillment, error)
+	CountFulfillmentsFunc        func(int64, interface{}) (int, error)
+	GetFulfillmentFunc           func(int64, int64, interface{}) (*goshopify.Fulfillment, error)
+	CreateFulfillmentFunc        func(int64, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+	UpdateFulfillmentFunc        func(int64, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+	CompleteFulfillmentFunc      func(int64, int64) (*goshopify.Fulfillment, error)
+	TransitionFulfillmentFunc    func(int64, int64) (*goshopify.Fulfillment, error)
+	CancelFulfillmentFunc        func(int64, int64) (*goshopify.Fulfillment, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *OrderServiceMock) List(a0 interface{}) (r0 []goshopify.Order, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *OrderServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.Order, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *OrderServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *OrderServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Order, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *OrderServiceMock) Create(a0 goshopify.Order) (r0 *goshopify.Order, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *OrderServiceMock) Update(a0 goshopify.Order) (r0 *goshopify.Order, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Cancel calls CancelFunc and records the call.
+func (m *OrderServiceMock) Cancel(a0 int64, a1 interface{}) (r0 *goshopify.Order, r1 error) {
+	m.record("Cancel", a0, a1)
+	if m.CancelFunc == nil {
+		return
+	}
+	return m.CancelFunc(a0, a1)
+}
+
+// Close calls CloseFunc and records the call.
+func (m *OrderServiceMock) Close(a0 int64) (r0 *goshopify.Order, r1 error) {
+	m.record("Close", a0)
+	if m.CloseFunc == nil {
+		return
+	}
+	return m.CloseFunc(a0)
+}
+
+// Open calls OpenFunc and records the call.
+func (m *OrderServiceMock) Open(a0 int64) (r0 *goshopify.Order, r1 error) {
+	m.record("Open", a0)
+	if m.OpenFunc == nil {
+		return
+	}
+	return m.OpenFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *OrderServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *OrderServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *OrderServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *OrderServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *OrderServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *OrderServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *OrderServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// ListEvents calls ListEventsFunc and records the call.
+func (m *OrderServiceMock) ListEvents(a0 int64, a1 interface{}) (r0 []goshopify.Event, r1 error) {
+	m.record("ListEvents", a0, a1)
+	if m.ListEventsFunc == nil {
+		return
+	}
+	return m.ListEventsFunc(a0, a1)
+}
+
+// ListEventsWithPagination calls ListEventsWithPaginationFunc and records the call.
+func (m *OrderServiceMock) ListEventsWithPagination(a0 int64, a1 interface{}) (r0 []goshopify.Event, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListEventsWithPagination", a0, a1)
+	if m.ListEventsWithPaginationFunc == nil {
+		return
+	}
+	return m.ListEventsWithPaginationFunc(a0, a1)
+}
+
+// ListFulfillments calls ListFulfillmentsFunc and records the call.
+func (m *OrderServiceMock) ListFulfillments(a0 int64, a1 interface{}) (r0 []goshopify.Fulfillment, r1 error) {
+	m.record("ListFulfillments", a0, a1)
+	if m.ListFulfillmentsFunc == nil {
+		return
+	}
+	return m.ListFulfillmentsFunc(a0, a1)
+}
+
+// CountFulfillments calls CountFulfillmentsFunc and records the call.
+func (m *OrderServiceMock) CountFulfillments(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountFulfillments", a0, a1)
+	if m.CountFulfillmentsFunc == nil {
+		return
+	}
+	return m.CountFulfillmentsFunc(a0, a1)
+}
+
+// GetFulfillment calls GetFulfillmentFunc and records the call.
+func (m *OrderServiceMock) GetFulfillment(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("GetFulfillment", a0, a1, a2)
+	if m.GetFulfillmentFunc == nil {
+		return
+	}
+	return m.GetFulfillmentFunc(a0, a1, a2)
+}
+
+// CreateFulfillment calls CreateFulfillmentFunc and records the call.
+func (m *OrderServiceMock) CreateFulfillment(a0 int64, a1 goshopify.Fulfillment) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("CreateFulfillment", a0, a1)
+	if m.CreateFulfillmentFunc == nil {
+		return
+	}
+	return m.CreateFulfillmentFunc(a0, a1)
+}
+
+// UpdateFulfillment calls UpdateFulfillmentFunc and records the call.
+func (m *OrderServiceMock) UpdateFulfillment(a0 int64, a1 goshopify.Fulfillment) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("UpdateFulfillment", a0, a1)
+	if m.UpdateFulfillmentFunc == nil {
+		return
+	}
+	return m.UpdateFulfillmentFunc(a0, a1)
+}
+
+// CompleteFulfillment calls CompleteFulfillmentFunc and records the call.
+func (m *OrderServiceMock) CompleteFulfillment(a0 int64, a1 int64) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("CompleteFulfillment", a0, a1)
+	if m.CompleteFulfillmentFunc == nil {
+		return
+	}
+	return m.CompleteFulfillmentFunc(a0, a1)
+}
+
+// TransitionFulfillment calls TransitionFulfillmentFunc and records the call.
+func (m *OrderServiceMock) TransitionFulfillment(a0 int64, a1 int64) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("TransitionFulfillment", a0, a1)
+	if m.TransitionFulfillmentFunc == nil {
+		return
+	}
+	return m.TransitionFulfillmentFunc(a0, a1)
+}
+
+// CancelFulfillment calls CancelFulfillmentFunc and records the call.
+func (m *OrderServiceMock) CancelFulfillment(a0 int64, a1 int64) (r0 *goshopify.Fulfillment, r1 error) {
+	m.record("CancelFulfillment", a0, a1)
+	if m.CancelFulfillmentFunc == nil {
+		return
+	}
+	return m.CancelFulfillmentFunc(a0, a1)
+}
+
+// PageServiceMock is a mock implementation of goshopify.PageService.
+// Calls to a method whose Func field is nil return zero values.
+type PageServiceMock struct {
+	Recorder
+
+	ListFunc            func(interface{}) ([]goshopify.Page, error)
+	CountFunc           func(interface{}) (int, error)
+	GetFunc             func(int64, interface{}) (*goshopify.Page, error)
+	CreateFunc          func(goshopify.Page) (*goshopify.Page, error)
+	UpdateFunc          func(goshopify.Page) (*goshopify.Page, error)
+	DeleteFunc          func(int64) error
+	ListMetafieldsFunc  func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc func(int64, interface{}) (int, error)
+	GetMetafieldFunc    func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *PageServiceMock) List(a0 interface{}) (r0 []goshopify.Page, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *PageServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *PageServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Page, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *PageServiceMock) Create(a0 goshopify.Page) (r0 *goshopify.Page, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *PageServiceMock) Update(a0 goshopify.Page) (r0 *goshopify.Page, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *PageServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *PageServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *PageServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *PageServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *PageServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *PageServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *PageServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// PaymentsTransactionsServiceMock is a mock implementation of goshopify.PaymentsTransactionsService.
+// Calls to a method whose Func field is nil return zero values.
+type PaymentsTransactionsServiceMock struct {
+	Recorder
+
+	ListFunc               func(interface{}) ([]goshopify.PaymentsTransactions, error)
+	ListWithPaginationFunc func(interface{}) ([]goshopify.PaymentsTransactions, *goshopify.Pagination, error)
+	GetFunc                func(int64, interface{}) (*goshopify.PaymentsTransactions, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *PaymentsTransactionsServiceMock) List(a0 interface{}) (r0 []goshopify.PaymentsTransactions, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *PaymentsTransactionsServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.PaymentsTransactions, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *PaymentsTransactionsServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.PaymentsTransactions, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// PayoutsServiceMock is a mock implementation of goshopify.PayoutsService.
+// Calls to a method whose Func field is nil return zero values.
+type PayoutsServiceMock struct {
+	Recorder
+
+	ListFunc               func(interface{}) ([]goshopify.Payout, error)
+	ListWithPaginationFunc func(interface{}) ([]goshopify.Payout, *goshopify.Pagination, error)
+	GetFunc                func(int64, interface{}) (*goshopify.Payout, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *PayoutsServiceMock) List(a0 interface{}) (r0 []goshopify.Payout, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *PayoutsServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.Payout, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *PayoutsServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Payout, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// PriceRuleServiceMock is a mock implementation of goshopify.PriceRuleService.
+// Calls to a method whose Func field is nil return zero values.
+type PriceRuleServiceMock struct {
+	Recorder
+
+	GetFunc    func(int64) (*goshopify.PriceRule, error)
+	CreateFunc func(goshopify.PriceRule) (*goshopify.PriceRule, error)
+	UpdateFunc func(goshopify.PriceRule) (*goshopify.PriceRule, error)
+	ListFunc   func() ([]goshopify.PriceRule, error)
+	DeleteFunc func(int64) error
+}
+
+// Get calls GetFunc and records the call.
+func (m *PriceRuleServiceMock) Get(a0 int64) (r0 *goshopify.PriceRule, r1 error) {
+	m.record("Get", a0)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *PriceRuleServiceMock) Create(a0 goshopify.PriceRule) (r0 *goshopify.PriceRule, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *PriceRuleServiceMock) Update(a0 goshopify.PriceRule) (r0 *goshopify.PriceRule, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// List calls ListFunc and records the call.
+func (m *PriceRuleServiceMock) List() (r0 []goshopify.PriceRule, r1 error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc()
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *PriceRuleServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ProductListingServiceMock is a mock implementation of goshopify.ProductListingService.
+// Calls to a method whose Func field is nil return zero values.
+type ProductListingServiceMock struct {
+	Recorder
+
+	ListFunc               func(interface{}) ([]goshopify.ProductListing, error)
+	ListWithPaginationFunc func(interface{}) ([]goshopify.ProductListing, *goshopify.Pagination, error)
+	CountFunc              func(interface{}) (int, error)
+	GetFunc                func(int64, interface{}) (*goshopify.ProductListing, error)
+	GetProductIDsFunc      func(interface{}) ([]int64, error)
+	PublishFunc            func(int64) (*goshopify.ProductListing, error)
+	DeleteFunc             func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *ProductListingServiceMock) List(a0 interface{}) (r0 []goshopify.ProductListing, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *ProductListingServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.ProductListing, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *ProductListingServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *ProductListingServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.ProductListing, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// GetProductIDs calls GetProductIDsFunc and records the call.
+func (m *ProductListingServiceMock) GetProductIDs(a0 interface{}) (r0 []int64, r1 error) {
+	m.record("GetProductIDs", a0)
+	if m.GetProductIDsFunc == nil {
+		return
+	}
+	return m.GetProductIDsFunc(a0)
+}
+
+// Publish calls PublishFunc and records the call.
+func (m *ProductListingServiceMock) Publish(a0 int64) (r0 *goshopify.ProductListing, r1 error) {
+	m.record("Publish", a0)
+	if m.PublishFunc == nil {
+		return
+	}
+	return m.PublishFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *ProductListingServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ProductServiceMock is a mock implementation of goshopify.ProductService.
+// Calls to a method whose Func field is nil return zero values.
+type ProductServiceMock struct {
+	Recorder
+
+	ListFunc                     func(interface{}) ([]goshopify.Product, error)
+	ListWithPaginationFunc       func(interface{}) ([]goshopify.Product, *goshopify.Pagination, error)
+	CountFunc                    func(interface{}) (int, error)
+	GetFunc                      func(int64, interface{}) (*goshopify.Product, error)
+	CreateFunc                   func(goshopify.Product) (*goshopify.Product, error)
+	UpdateFunc                   func(goshopify.Product) (*goshopify.Product, error)
+	DeleteFunc                   func(int64) error
+	ListMetafieldsFunc           func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc          func(int64, interface{}) (int, error)
+	GetMetafieldFunc             func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc          func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc          func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc          func(int64, int64) error
+	ListEventsFunc               func(int64, interface{}) ([]goshopify.Event, error)
+	ListEventsWithPaginationFunc func(int64, interface{}) ([]goshopify.Event, *goshopify.Pagination, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *ProductServiceMock) List(a0 interface{}) (r0 []goshopify.Product, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *ProductServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.Product, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *ProductServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *ProductServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Product, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *ProductServiceMock) Create(a0 goshopify.Product) (r0 *goshopify.Product, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *ProductServiceMock) Update(a0 goshopify.Product) (r0 *goshopify.Product, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *ProductServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *ProductServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *ProductServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *ProductServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *ProductServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *ProductServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *ProductServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// ListEvents calls ListEventsFunc and records the call.
+func (m *ProductServiceMock) ListEvents(a0 int64, a1 interface{}) (r0 []goshopify.Event, r1 error) {
+	m.record("ListEvents", a0, a1)
+	if m.ListEventsFunc == nil {
+		return
+	}
+	return m.ListEventsFunc(a0, a1)
+}
+
+// ListEventsWithPagination calls ListEventsWithPaginationFunc and records the call.
+func (m *ProductServiceMock) ListEventsWithPagination(a0 int64, a1 interface{}) (r0 []goshopify.Event, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListEventsWithPagination", a0, a1)
+	if m.ListEventsWithPaginationFunc == nil {
+		return
+	}
+	return m.ListEventsWithPaginationFunc(a0, a1)
+}
+
+// ProvinceServiceMock is a mock implementation of goshopify.ProvinceService.
+// Calls to a method whose Func field is nil return zero values.
+type ProvinceServiceMock struct {
+	Recorder
+
+	ListFunc   func(int64, interface{}) ([]goshopify.Province, error)
+	CountFunc  func(int64, interface{}) (int, error)
+	GetFunc    func(int64, int64, interface{}) (*goshopify.Province, error)
+	UpdateFunc func(int64, goshopify.Province) (*goshopify.Province, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *ProvinceServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.Province, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// Count calls CountFunc and records the call.
+func (m *ProvinceServiceMock) Count(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0, a1)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *ProvinceServiceMock) Get(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Province, r1 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1, a2)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *ProvinceServiceMock) Update(a0 int64, a1 goshopify.Province) (r0 *goshopify.Province, r1 error) {
+	m.record("Update", a0, a1)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0, a1)
+}
+
+// RecurringApplicationChargeServiceMock is a mock implementation of goshopify.RecurringApplicationChargeService.
+// Calls to a method whose Func field is nil return zero values.
+type RecurringApplicationChargeServiceMock struct {
+	Recorder
+
+	CreateFunc   func(goshopify.RecurringApplicationCharge) (*goshopify.RecurringApplicationCharge, error)
+	GetFunc      func(int64, interface{}) (*goshopify.RecurringApplicationCharge, error)
+	ListFunc     func(interface{}) ([]goshopify.RecurringApplicationCharge, error)
+	ActivateFunc func(goshopify.RecurringApplicationCharge) (*goshopify.RecurringApplicationCharge, error)
+	DeleteFunc   func(int64) error
+	UpdateFunc   func(int64, int64) (*goshopify.RecurringApplicationCharge, error)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *RecurringApplicationChargeServiceMock) Create(a0 goshopify.RecurringApplicationCharge) (r0 *goshopify.RecurringApplicationCharge, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *RecurringApplicationChargeServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.RecurringApplicationCharge, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// List calls ListFunc and records the call.
+func (m *RecurringApplicationChargeServiceMock) List(a0 interface{}) (r0 []goshopify.RecurringApplicationCharge, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Activate calls ActivateFunc and records the call.
+func (m *RecurringApplicationChargeServiceMock) Activate(a0 goshopify.RecurringApplicationCharge) (r0 *goshopify.RecurringApplicationCharge, r1 error) {
+	m.record("Activate", a0)
+	if m.ActivateFunc == nil {
+		return
+	}
+	return m.ActivateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *RecurringApplicationChargeServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *RecurringApplicationChargeServiceMock) Update(a0 int64, a1 int64) (r0 *goshopify.RecurringApplicationCharge, r1 error) {
+	m.record("Update", a0, a1)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0, a1)
+}
+
+// RedirectServiceMock is a mock implementation of goshopify.RedirectService.
+// Calls to a method whose Func field is nil return zero values.
+type RedirectServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.Redirect, error)
+	CountFunc  func(interface{}) (int, error)
+	GetFunc    func(int64, interface{}) (*goshopify.Redirect, error)
+	CreateFunc func(goshopify.Redirect) (*goshopify.Redirect, error)
+	UpdateFunc func(goshopify.Redirect) (*goshopify.Redirect, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *RedirectServiceMock) List(a0 interface{}) (r0 []goshopify.Redirect, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *RedirectServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *RedirectServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Redirect, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *RedirectServiceMock) Create(a0 goshopify.Redirect) (r0 *goshopify.Redirect, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *RedirectServiceMock) Update(a0 goshopify.Redirect) (r0 *goshopify.Redirect, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *RedirectServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// RefundServiceMock is a mock implementation of goshopify.RefundService.
+// Calls to a method whose Func field is nil return zero values.
+type RefundServiceMock struct {
+	Recorder
+
+	ListFunc               func(int64, interface{}) ([]goshopify.Refund, error)
+	ListWithPaginationFunc func(int64, interface{}) ([]goshopify.Refund, *goshopify.Pagination, error)
+	GetFunc                func(int64, int64, interface{}) (*goshopify.Refund, error)
+	CalculateFunc          func(int64, goshopify.Refund) (*goshopify.Refund, error)
+	CreateFunc             func(int64, goshopify.Refund) (*goshopify.Refund, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *RefundServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.Refund, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *RefundServiceMock) ListWithPagination(a0 int64, a1 interface{}) (r0 []goshopify.Refund, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0, a1)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *RefundServiceMock) Get(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Refund, r1 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1, a2)
+}
+
+// Calculate calls CalculateFunc and records the call.
+func (m *RefundServiceMock) Calculate(a0 int64, a1 goshopify.Refund) (r0 *goshopify.Refund, r1 error) {
+	m.record("Calculate", a0, a1)
+	if m.CalculateFunc == nil {
+		return
+	}
+	return m.CalculateFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *RefundServiceMock) Create(a0 int64, a1 goshopify.Refund) (r0 *goshopify.Refund, r1 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+// ResourceFeedbackServiceMock is a mock implementation of goshopify.ResourceFeedbackService.
+// Calls to a method whose Func field is nil return zero values.
+type ResourceFeedbackServiceMock struct {
+	Recorder
+
+	ListFunc                  func() ([]goshopify.ResourceFeedback, error)
+	CreateFunc                func(goshopify.ResourceFeedback) (*goshopify.ResourceFeedback, error)
+	ListProductFeedbackFunc   func(int64) ([]goshopify.ResourceFeedback, error)
+	CreateProductFeedbackFunc func(int64, goshopify.ResourceFeedback) (*goshopify.ResourceFeedback, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *ResourceFeedbackServiceMock) List() (r0 []goshopify.ResourceFeedback, r1 error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc()
+}
+
+// Create calls CreateFunc and records the call.
+func (m *ResourceFeedbackServiceMock) Create(a0 goshopify.ResourceFeedback) (r0 *goshopify.ResourceFeedback, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// ListProductFeedback calls ListProductFeedbackFunc and records the call.
+func (m *ResourceFeedbackServiceMock) ListProductFeedback(a0 int64) (r0 []goshopify.ResourceFeedback, r1 error) {
+	m.record("ListProductFeedback", a0)
+	if m.ListProductFeedbackFunc == nil {
+		return
+	}
+	return m.ListProductFeedbackFunc(a0)
+}
+
+// CreateProductFeedback calls CreateProductFeedbackFunc and records the call.
+func (m *ResourceFeedbackServiceMock) CreateProductFeedback(a0 int64, a1 goshopify.ResourceFeedback) (r0 *goshopify.ResourceFeedback, r1 error) {
+	m.record("CreateProductFeedback", a0, a1)
+	if m.CreateProductFeedbackFunc == nil {
+		return
+	}
+	return m.CreateProductFeedbackFunc(a0, a1)
+}
+
+// ScriptTagServiceMock is a mock implementation of goshopify.ScriptTagService.
+// Calls to a method whose Func field is nil return zero values.
+type ScriptTagServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.ScriptTag, error)
+	CountFunc  func(interface{}) (int, error)
+	GetFunc    func(int64, interface{}) (*goshopify.ScriptTag, error)
+	CreateFunc func(goshopify.ScriptTag) (*goshopify.ScriptTag, error)
+	UpdateFunc func(goshopify.ScriptTag) (*goshopify.ScriptTag, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *ScriptTagServiceMock) List(a0 interface{}) (r0 []goshopify.ScriptTag, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *ScriptTagServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *ScriptTagServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.ScriptTag, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *ScriptTagServiceMock) Create(a0 goshopify.ScriptTag) (r0 *goshopify.ScriptTag, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *ScriptTagServiceMock) Update(a0 goshopify.ScriptTag) (r0 *goshopify.ScriptTag, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *ScriptTagServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ShippingZoneServiceMock is a mock implementation of goshopify.ShippingZoneService.
+// Calls to a method whose Func field is nil return zero values.
+type ShippingZoneServiceMock struct {
+	Recorder
+
+	ListFunc func() ([]goshopify.ShippingZone, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *ShippingZoneServiceMock) List() (r0 []goshopify.ShippingZone, r1 error) {
+	m.record("List")
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc()
+}
+
+// ShopServiceMock is a mock implementation of goshopify.ShopService.
+// Calls to a method whose Func field is nil return zero values.
+type ShopServiceMock struct {
+	Recorder
+
+	GetFunc             func(interface{}) (*goshopify.Shop, error)
+	ListMetafieldsFunc  func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc func(int64, interface{}) (int, error)
+	GetMetafieldFunc    func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc func(int64, int64) error
+}
+
+// Get calls GetFunc and records the call.
+func (m *ShopServiceMock) Get(a0 interface{}) (r0 *goshopify.Shop, r1 error) {
+	m.record("Get", a0)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *ShopServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *ShopServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *ShopServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *ShopServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *ShopServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *ShopServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// SmartCollectionServiceMock is a mock implementation of goshopify.SmartCollectionService.
+// Calls to a method whose Func field is nil return zero values.
+type SmartCollectionServiceMock struct {
+	Recorder
+
+	ListFunc            func(interface{}) ([]goshopify.SmartCollection, error)
+	CountFunc           func(interface{}) (int, error)
+	GetFunc             func(int64, interface{}) (*goshopify.SmartCollection, error)
+	CreateFunc          func(goshopify.SmartCollection) (*goshopify.SmartCollection, error)
+	UpdateFunc          func(goshopify.SmartCollection) (*goshopify.SmartCollection, error)
+	DeleteFunc          func(int64) error
+	ListMetafieldsFunc  func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc func(int64, interface{}) (int, error)
+	GetMetafieldFunc    func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *SmartCollectionServiceMock) List(a0 interface{}) (r0 []goshopify.SmartCollection, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *SmartCollectionServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *SmartCollectionServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.SmartCollection, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *SmartCollectionServiceMock) Create(a0 goshopify.SmartCollection) (r0 *goshopify.SmartCollection, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *SmartCollectionServiceMock) Update(a0 goshopify.SmartCollection) (r0 *goshopify.SmartCollection, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *SmartCollectionServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *SmartCollectionServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *SmartCollectionServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *SmartCollectionServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *SmartCollectionServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *SmartCollectionServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *SmartCollectionServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// StorefrontAccessTokenServiceMock is a mock implementation of goshopify.StorefrontAccessTokenService.
+// Calls to a method whose Func field is nil return zero values.
+type StorefrontAccessTokenServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.StorefrontAccessToken, error)
+	CreateFunc func(goshopify.StorefrontAccessToken) (*goshopify.StorefrontAccessToken, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *StorefrontAccessTokenServiceMock) List(a0 interface{}) (r0 []goshopify.StorefrontAccessToken, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *StorefrontAccessTokenServiceMock) Create(a0 goshopify.StorefrontAccessToken) (r0 *goshopify.StorefrontAccessToken, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *StorefrontAccessTokenServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// TenderTransactionServiceMock is a mock implementation of goshopify.TenderTransactionService.
+// Calls to a method whose Func field is nil return zero values.
+type TenderTransactionServiceMock struct {
+	Recorder
+
+	ListFunc               func(interface{}) ([]goshopify.TenderTransaction, error)
+	ListWithPaginationFunc func(interface{}) ([]goshopify.TenderTransaction, *goshopify.Pagination, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *TenderTransactionServiceMock) List(a0 interface{}) (r0 []goshopify.TenderTransaction, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// ListWithPagination calls ListWithPaginationFunc and records the call.
+func (m *TenderTransactionServiceMock) ListWithPagination(a0 interface{}) (r0 []goshopify.TenderTransaction, r1 *goshopify.Pagination, r2 error) {
+	m.record("ListWithPagination", a0)
+	if m.ListWithPaginationFunc == nil {
+		return
+	}
+	return m.ListWithPaginationFunc(a0)
+}
+
+// ThemeServiceMock is a mock implementation of goshopify.ThemeService.
+// Calls to a method whose Func field is nil return zero values.
+type ThemeServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.Theme, error)
+	CreateFunc func(goshopify.Theme) (*goshopify.Theme, error)
+	GetFunc    func(int64, interface{}) (*goshopify.Theme, error)
+	UpdateFunc func(goshopify.Theme) (*goshopify.Theme, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *ThemeServiceMock) List(a0 interface{}) (r0 []goshopify.Theme, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *ThemeServiceMock) Create(a0 goshopify.Theme) (r0 *goshopify.Theme, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *ThemeServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Theme, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *ThemeServiceMock) Update(a0 goshopify.Theme) (r0 *goshopify.Theme, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *ThemeServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// TransactionServiceMock is a mock implementation of goshopify.TransactionService.
+// Calls to a method whose Func field is nil return zero values.
+type TransactionServiceMock struct {
+	Recorder
+
+	ListFunc   func(int64, interface{}) ([]goshopify.Transaction, error)
+	CountFunc  func(int64, interface{}) (int, error)
+	GetFunc    func(int64, int64, interface{}) (*goshopify.Transaction, error)
+	CreateFunc func(int64, goshopify.Transaction) (*goshopify.Transaction, error)
+}
+
+// List calls ListFunc and records the call.
+func (m *TransactionServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.Transaction, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// Count calls CountFunc and records the call.
+func (m *TransactionServiceMock) Count(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0, a1)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *TransactionServiceMock) Get(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Transaction, r1 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1, a2)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *TransactionServiceMock) Create(a0 int64, a1 goshopify.Transaction) (r0 *goshopify.Transaction, r1 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+// UsageChargeServiceMock is a mock implementation of goshopify.UsageChargeService.
+// Calls to a method whose Func field is nil return zero values.
+type UsageChargeServiceMock struct {
+	Recorder
+
+	CreateFunc func(int64, goshopify.UsageCharge) (*goshopify.UsageCharge, error)
+	GetFunc    func(int64, int64, interface{}) (*goshopify.UsageCharge, error)
+	ListFunc   func(int64, interface{}) ([]goshopify.UsageCharge, error)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *UsageChargeServiceMock) Create(a0 int64, a1 goshopify.UsageCharge) (r0 *goshopify.UsageCharge, r1 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *UsageChargeServiceMock) Get(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.UsageCharge, r1 error) {
+	m.record("Get", a0, a1, a2)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1, a2)
+}
+
+// List calls ListFunc and records the call.
+func (m *UsageChargeServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.UsageCharge, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// VariantServiceMock is a mock implementation of goshopify.VariantService.
+// Calls to a method whose Func field is nil return zero values.
+type VariantServiceMock struct {
+	Recorder
+
+	ListFunc            func(int64, interface{}) ([]goshopify.Variant, error)
+	CountFunc           func(int64, interface{}) (int, error)
+	GetFunc             func(int64, interface{}) (*goshopify.Variant, error)
+	CreateFunc          func(int64, goshopify.Variant) (*goshopify.Variant, error)
+	UpdateFunc          func(goshopify.Variant) (*goshopify.Variant, error)
+	DeleteFunc          func(int64, int64) error
+	ListMetafieldsFunc  func(int64, interface{}) ([]goshopify.Metafield, error)
+	CountMetafieldsFunc func(int64, interface{}) (int, error)
+	GetMetafieldFunc    func(int64, int64, interface{}) (*goshopify.Metafield, error)
+	CreateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	UpdateMetafieldFunc func(int64, goshopify.Metafield) (*goshopify.Metafield, error)
+	DeleteMetafieldFunc func(int64, int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *VariantServiceMock) List(a0 int64, a1 interface{}) (r0 []goshopify.Variant, r1 error) {
+	m.record("List", a0, a1)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0, a1)
+}
+
+// Count calls CountFunc and records the call.
+func (m *VariantServiceMock) Count(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0, a1)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0, a1)
+}
+
+// Get calls GetFunc and records the call.
+func (m *VariantServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Variant, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *VariantServiceMock) Create(a0 int64, a1 goshopify.Variant) (r0 *goshopify.Variant, r1 error) {
+	m.record("Create", a0, a1)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0, a1)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *VariantServiceMock) Update(a0 goshopify.Variant) (r0 *goshopify.Variant, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *VariantServiceMock) Delete(a0 int64, a1 int64) (r0 error) {
+	m.record("Delete", a0, a1)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0, a1)
+}
+
+// ListMetafields calls ListMetafieldsFunc and records the call.
+func (m *VariantServiceMock) ListMetafields(a0 int64, a1 interface{}) (r0 []goshopify.Metafield, r1 error) {
+	m.record("ListMetafields", a0, a1)
+	if m.ListMetafieldsFunc == nil {
+		return
+	}
+	return m.ListMetafieldsFunc(a0, a1)
+}
+
+// CountMetafields calls CountMetafieldsFunc and records the call.
+func (m *VariantServiceMock) CountMetafields(a0 int64, a1 interface{}) (r0 int, r1 error) {
+	m.record("CountMetafields", a0, a1)
+	if m.CountMetafieldsFunc == nil {
+		return
+	}
+	return m.CountMetafieldsFunc(a0, a1)
+}
+
+// GetMetafield calls GetMetafieldFunc and records the call.
+func (m *VariantServiceMock) GetMetafield(a0 int64, a1 int64, a2 interface{}) (r0 *goshopify.Metafield, r1 error) {
+	m.record("GetMetafield", a0, a1, a2)
+	if m.GetMetafieldFunc == nil {
+		return
+	}
+	return m.GetMetafieldFunc(a0, a1, a2)
+}
+
+// CreateMetafield calls CreateMetafieldFunc and records the call.
+func (m *VariantServiceMock) CreateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("CreateMetafield", a0, a1)
+	if m.CreateMetafieldFunc == nil {
+		return
+	}
+	return m.CreateMetafieldFunc(a0, a1)
+}
+
+// UpdateMetafield calls UpdateMetafieldFunc and records the call.
+func (m *VariantServiceMock) UpdateMetafield(a0 int64, a1 goshopify.Metafield) (r0 *goshopify.Metafield, r1 error) {
+	m.record("UpdateMetafield", a0, a1)
+	if m.UpdateMetafieldFunc == nil {
+		return
+	}
+	return m.UpdateMetafieldFunc(a0, a1)
+}
+
+// DeleteMetafield calls DeleteMetafieldFunc and records the call.
+func (m *VariantServiceMock) DeleteMetafield(a0 int64, a1 int64) (r0 error) {
+	m.record("DeleteMetafield", a0, a1)
+	if m.DeleteMetafieldFunc == nil {
+		return
+	}
+	return m.DeleteMetafieldFunc(a0, a1)
+}
+
+// WebhookServiceMock is a mock implementation of goshopify.WebhookService.
+// Calls to a method whose Func field is nil return zero values.
+type WebhookServiceMock struct {
+	Recorder
+
+	ListFunc   func(interface{}) ([]goshopify.Webhook, error)
+	CountFunc  func(interface{}) (int, error)
+	GetFunc    func(int64, interface{}) (*goshopify.Webhook, error)
+	CreateFunc func(goshopify.Webhook) (*goshopify.Webhook, error)
+	UpdateFunc func(goshopify.Webhook) (*goshopify.Webhook, error)
+	DeleteFunc func(int64) error
+}
+
+// List calls ListFunc and records the call.
+func (m *WebhookServiceMock) List(a0 interface{}) (r0 []goshopify.Webhook, r1 error) {
+	m.record("List", a0)
+	if m.ListFunc == nil {
+		return
+	}
+	return m.ListFunc(a0)
+}
+
+// Count calls CountFunc and records the call.
+func (m *WebhookServiceMock) Count(a0 interface{}) (r0 int, r1 error) {
+	m.record("Count", a0)
+	if m.CountFunc == nil {
+		return
+	}
+	return m.CountFunc(a0)
+}
+
+// Get calls GetFunc and records the call.
+func (m *WebhookServiceMock) Get(a0 int64, a1 interface{}) (r0 *goshopify.Webhook, r1 error) {
+	m.record("Get", a0, a1)
+	if m.GetFunc == nil {
+		return
+	}
+	return m.GetFunc(a0, a1)
+}
+
+// Create calls CreateFunc and records the call.
+func (m *WebhookServiceMock) Create(a0 goshopify.Webhook) (r0 *goshopify.Webhook, r1 error) {
+	m.record("Create", a0)
+	if m.CreateFunc == nil {
+		return
+	}
+	return m.CreateFunc(a0)
+}
+
+// Update calls UpdateFunc and records the call.
+func (m *WebhookServiceMock) Update(a0 goshopify.Webhook) (r0 *goshopify.Webhook, r1 error) {
+	m.record("Update", a0)
+	if m.UpdateFunc == nil {
+		return
+	}
+	return m.UpdateFunc(a0)
+}
+
+// Delete calls DeleteFunc and records the call.
+func (m *WebhookServiceMock) Delete(a0 int64) (r0 error) {
+	m.record("Delete", a0)
+	if m.DeleteFunc == nil {
+		return
+	}
+	return m.DeleteFunc(a0)
+}
+
+// services maps the name of every mocked service interface to a new mock
+var services = map[string]interface{}{
+	"AbandonedCheckoutService":          &AbandonedCheckoutServiceMock{},
+	"AccessScopesService":               &AccessScopesServiceMock{},
+	"ApplicationChargeService":          &ApplicationChargeServiceMock{},
+	"ArticleService":                    &ArticleServiceMock{},
+	"AssetService":                      &AssetServiceMock{},
+	"AssignedFulfillmentOrderService":   &AssignedFulfillmentOrderServiceMock{},
+	"BalanceService":                    &BalanceServiceMock{},
+	"BlogService":                       &BlogServiceMock{},
+	"CancellationRequestService":        &CancellationRequestServiceMock{},
+	"CarrierServiceService":             &CarrierServiceServiceMock{},
+	"CollectService":                    &CollectServiceMock{},
+	"CollectionListingService":          &CollectionListingServiceMock{},
+	"CollectionService":                 &CollectionServiceMock{},
+	"CommentService":                    &CommentServiceMock{},
+	"CountryService":                    &CountryServiceMock{},
+	"CustomCollectionService":           &CustomCollectionServiceMock{},
+	"CustomerAddressService":            &CustomerAddressServiceMock{},
+	"CustomerSavedSearchService":        &CustomerSavedSearchServiceMock{},
+	"CustomerService":                   &CustomerServiceMock{},
+	"DiscountCodeService":               &DiscountCodeServiceMock{},
+	"DisputeService":                    &DisputeServiceMock{},
+	"DraftOrderService":                 &DraftOrderServiceMock{},
+	"EventService":                      &EventServiceMock{},
+	"EventsService":                     &EventsServiceMock{},
+	"FulfillmentEventService":           &FulfillmentEventServiceMock{},
+	"FulfillmentOrderService":           &FulfillmentOrderServiceMock{},
+	"FulfillmentRequestService":         &FulfillmentRequestServiceMock{},
+	"FulfillmentService":                &FulfillmentServiceMock{},
+	"FulfillmentServiceService":         &FulfillmentServiceServiceMock{},
+	"FulfillmentsService":               &FulfillmentsServiceMock{},
+	"GiftCardService":                   &GiftCardServiceMock{},
+	"GraphQLService":                    &GraphQLServiceMock{},
+	"ImageService":                      &ImageServiceMock{},
+	"InventoryItemService":              &InventoryItemServiceMock{},
+	"InventoryLevelService":             &InventoryLevelServiceMock{},
+	"LocationService":                   &LocationServiceMock{},
+	"MetafieldService":                  &MetafieldServiceMock{},
+	"MetafieldsService":                 &MetafieldsServiceMock{},
+	"OrderEditService":                  &OrderEditServiceMock{},
+	"OrderRiskService":                  &OrderRiskServiceMock{},
+	"OrderService":                      &OrderServiceMock{},
+	"PageService":                       &PageServiceMock{},
+	"PaymentsTransactionsService":       &PaymentsTransactionsServiceMock{},
+	"PayoutsService":                    &PayoutsServiceMock{},
+	"PriceRuleService":                  &PriceRuleServiceMock{},
+	"ProductListingService":             &ProductListingServiceMock{},
+	"ProductService":                    &ProductServiceMock{},
+	"ProvinceService":                   &ProvinceServiceMock{},
+	"RecurringApplicationChargeService": &RecurringApplicationChargeServiceMock{},
+	"RedirectService":                   &RedirectServiceMock{},
+	"RefundService":                     &RefundServiceMock{},
+	"ResourceFeedbackService":           &ResourceFeedbackServiceMock{},
+	"ScriptTagService":                  &ScriptTagServiceMock{},
+	"ShippingZoneService":               &ShippingZoneServiceMock{},
+	"ShopService":                       &ShopServiceMock{},
+	"SmartCollectionService":            &SmartCollectionServiceMock{},
+	"StorefrontAccessTokenService":      &StorefrontAccessTokenServiceMock{},
+	"TenderTransactionService":          &TenderTransactionServiceMock{},
+	"ThemeService":                      &ThemeServiceMock{},
+	"TransactionService":                &TransactionServiceMock{},
+	"UsageChargeService":                &UsageChargeServiceMock{},
+	"VariantService":                    &VariantServiceMock{},
+	"WebhookService":                    &WebhookServiceMock{},
+}
@@ -3,6 +3,7 @@ package goshopify
 import (
 	"bytes"
 	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
@@ -13,16 +14,69 @@ import (
 	"net/url"
 	"sort"
 	"strings"
+	"time"
 )
 
 const shopifyChecksumHeader = "X-Shopify-Hmac-Sha256"
 
 var accessTokenRelPath = "admin/oauth/access_token"
 
+const (
+	tokenExchangeGrantType        = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenExchangeSubjectTokenType = "urn:ietf:params:oauth:token-type:id_token"
+)
+
+// RequestedTokenType is the type of access token requested in a token exchange
+type RequestedTokenType string
+
+const (
+	OnlineAccessToken  RequestedTokenType = "urn:shopify:params:oauth:token-type:online-access-token"
+	OfflineAccessToken RequestedTokenType = "urn:shopify:params:oauth:token-type:offline-access-token"
+)
+
+// AccessTokenResponse represents the response from the admin/oauth/access_token
+// endpoint. The expiry and associated user fields are only set for online
+// (per-user) access tokens.
+type AccessTokenResponse struct {
+	AccessToken         string          `json:"access_token"`
+	Scope               string          `json:"scope,omitempty"`
+	ExpiresIn           int             `json:"expires_in,omitempty"`
+	AssociatedUserScope string          `json:"associated_user_scope,omitempty"`
+	AssociatedUser      *AssociatedUser `json:"associated_user,omitempty"`
+}
+
+// AssociatedUser represents the shop staff member an online access token was
+// issued for.
+type AssociatedUser struct {
+	ID            int64  `json:"id,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	AccountOwner  bool   `json:"account_owner,omitempty"`
+	Locale        string `json:"locale,omitempty"`
+	Collaborator  bool   `json:"collaborator,omitempty"`
+}
+
+// IsOnline reports whether the token is an online (per-user) access token.
+func (t AccessTokenResponse) IsOnline() bool {
+	return t.AssociatedUser != nil || t.ExpiresIn > 0
+}
+
+// ExpiresAt returns the time the token expires when it was issued at the
+// given time, or the zero time for offline tokens.
+func (t AccessTokenResponse) ExpiresAt(issuedAt time.Time) time.Time {
+	if t.ExpiresIn <= 0 {
+		return time.Time{}
+	}
+	return issuedAt.Add(time.Duration(t.ExpiresIn) * time.Second)
+}
+
 // Returns a Shopify oauth authorization url for the given shopname and state.
 //
 // State is a unique value that can be used to check the authenticity during a
 // callback from Shopify.
+// If app.PerUser is set, an online (per-user) access token is requested.
 func (app App) AuthorizeUrl(shopName string, state string) string {
 	shopUrl, _ := url.Parse(ShopBaseUrl(shopName))
 	shopUrl.Path = "/admin/oauth/authorize"
@@ -31,15 +85,27 @@ func (app App) AuthorizeUrl(shopName string, state string) string {
 	query.Set("redirect_uri", app.RedirectUrl)
 	query.Set("scope", app.Scope)
 	query.Set("state", state)
+	if app.PerUser {
+		query.Set("grant_options[]", "per-user")
+	}
 	shopUrl.RawQuery = query.Encode()
 	return shopUrl.String()
 }
 
+// GetAccessToken exchanges an authorization code for an access token.
+// Use GetAccessTokenResponse to also receive the scope, expiry and associated
+// user of online access tokens.
 func (app App) GetAccessToken(shopName string, code string) (string, error) {
-	type Token struct {
-		Token string `json:"access_token"`
+	token, err := app.GetAccessTokenResponse(shopName, code)
+	if err != nil {
+		return "", err
 	}
+	return token.AccessToken, nil
+}
 
+// GetAccessTokenResponse exchanges an authorization code for an access token
+// and returns the full token response.
+func (app App) GetAccessTokenResponse(shopName string, code string) (*AccessTokenResponse, error) {
 	data := struct {
 		ClientId     string `json:"client_id"`
 		ClientSecret string `json:"client_secret"`
@@ -52,17 +118,69 @@ func (app App) GetAccessToken(shopName string, code string) (string, error) {
 
 	client := app.Client
 	if client == nil {
-		client = NewClient(app, shopName, "")
+		// the shop comes from the OAuth redirect, validate it before sending
+		// the client secret to it
+		var err error
+		client, err = NewClientE(app, shopName, "")
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	req, err := client.NewRequest("POST", accessTokenRelPath, data, nil)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	token := new(AccessTokenResponse)
+	err = client.Do(req, token)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// TokenExchange exchanges a session token, see VerifySessionToken, for an
+// online or offline access token. This is used by apps relying on Shopify
+// managed installation instead of the authorization code grant.
+// See https://shopify.dev/docs/apps/auth/get-access-tokens/token-exchange
+func (app App) TokenExchange(shopName string, sessionToken string, tokenType RequestedTokenType) (*AccessTokenResponse, error) {
+	data := struct {
+		ClientId           string             `json:"client_id"`
+		ClientSecret       string             `json:"client_secret"`
+		GrantType          string             `json:"grant_type"`
+		SubjectToken       string             `json:"subject_token"`
+		SubjectTokenType   string             `json:"subject_token_type"`
+		RequestedTokenType RequestedTokenType `json:"requested_token_type"`
+	}{
+		ClientId:           app.ApiKey,
+		ClientSecret:       app.ApiSecret,
+		GrantType:          tokenExchangeGrantType,
+		SubjectToken:       sessionToken,
+		SubjectTokenType:   tokenExchangeSubjectTokenType,
+		RequestedTokenType: tokenType,
+	}
+
+	client := app.Client
+	if client == nil {
+		var err error
+		client, err = NewClientE(app, shopName, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := client.NewRequest("POST", accessTokenRelPath, data, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	token := new(Token)
+	token := new(AccessTokenResponse)
 	err = client.Do(req, token)
-	return token.Token, err
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
 }
 
 // Verify a message against a message HMAC
@@ -151,6 +269,60 @@ func (app App) VerifyWebhookRequestVerbose(httpRequest *http.Request) (bool, err
 	return HMACSame, nil
 }
 
+// SignWebhook returns the value of the X-Shopify-Hmac-Sha256 header Shopify
+// would send with a webhook with the given body. It is the inverse of
+// VerifyWebhookRequest and is mostly useful to test webhook handlers.
+func (app App) SignWebhook(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(app.ApiSecret))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// TestWebhook describes a webhook delivery built by NewTestWebhookRequest.
+// WebhookId and TriggeredAt are generated when empty.
+type TestWebhook struct {
+	Topic       string
+	ShopDomain  string
+	ApiVersion  string
+	WebhookId   string
+	TriggeredAt time.Time
+	Body        []byte
+}
+
+// NewTestWebhookRequest returns a POST request to targetUrl carrying the
+// webhook body with all the headers Shopify sends, signed with the app secret
+// so that it passes VerifyWebhookRequest.
+func (app App) NewTestWebhookRequest(targetUrl string, webhook TestWebhook) (*http.Request, error) {
+	req, err := http.NewRequest("POST", targetUrl, bytes.NewReader(webhook.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook.WebhookId == "" {
+		id := make([]byte, 16)
+		if _, err := rand.Read(id); err != nil {
+			return nil, err
+		}
+		webhook.WebhookId = hex.EncodeToString(id)
+	}
+	if webhook.TriggeredAt.IsZero() {
+		webhook.TriggeredAt = time.Now()
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Shopify-Captain-Hook")
+	req.Header.Set("X-Shopify-Topic", webhook.Topic)
+	req.Header.Set("X-Shopify-Shop-Domain", webhook.ShopDomain)
+	req.Header.Set("X-Shopify-Webhook-Id", webhook.WebhookId)
+	req.Header.Set("X-Shopify-Triggered-At", webhook.TriggeredAt.UTC().Format(time.RFC3339Nano))
+	if webhook.ApiVersion != "" {
+		req.Header.Set("X-Shopify-API-Version", webhook.ApiVersion)
+	}
+	req.Header.Set(shopifyChecksumHeader, app.SignWebhook(webhook.Body))
+
+	return req, nil
+}
+
 // Verifies an app proxy request, sent by Shopify.
 // When Shopify proxies HTTP requests to the proxy URL,
 // Shopify adds a signature paramter that is used to verify that the request was sent by Shopify.
@@ -2,12 +2,15 @@ package goshopify
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 )
@@ -52,6 +55,156 @@ func TestAppGetAccessToken(t *testing.T) {
 	}
 }
 
+func TestAppAuthorizeUrlPerUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	app.PerUser = true
+	expected := "https://fooshop.myshopify.com/admin/oauth/authorize?client_id=apikey&grant_options%5B%5D=per-user&redirect_uri=https%3A%2F%2Fexample.com%2Fcallback&scope=read_products&state=thenonce"
+
+	actual := app.AuthorizeUrl("fooshop", "thenonce")
+	if actual != expected {
+		t.Errorf("App.AuthorizeUrl(): expected %s, actual %s", expected, actual)
+	}
+}
+
+func TestAppGetAccessTokenResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/oauth/access_token",
+		httpmock.NewBytesResponder(200, loadFixture("access_token_online.json")))
+
+	app.Client = client
+	token, err := app.GetAccessTokenResponse("fooshop", "foocode")
+	if err != nil {
+		t.Fatalf("App.GetAccessTokenResponse(): %v", err)
+	}
+
+	expected := &AccessTokenResponse{
+		AccessToken:         "f85632530bf277ec9ac6f649fc327f17",
+		Scope:               "write_orders",
+		ExpiresIn:           86399,
+		AssociatedUserScope: "write_orders",
+		AssociatedUser: &AssociatedUser{
+			ID:            902541635,
+			FirstName:     "John",
+			LastName:      "Smith",
+			Email:         "john@example.com",
+			EmailVerified: true,
+			AccountOwner:  true,
+			Locale:        "en",
+			Collaborator:  false,
+		},
+	}
+	if !reflect.DeepEqual(token, expected) {
+		t.Errorf("App.GetAccessTokenResponse() returned %+v, expected %+v", token, expected)
+	}
+
+	if !token.IsOnline() {
+		t.Errorf("AccessTokenResponse.IsOnline() = false, expected true")
+	}
+
+	issuedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	expectedExpiry := issuedAt.Add(86399 * time.Second)
+	if !token.ExpiresAt(issuedAt).Equal(expectedExpiry) {
+		t.Errorf("AccessTokenResponse.ExpiresAt() = %s, expected %s", token.ExpiresAt(issuedAt), expectedExpiry)
+	}
+}
+
+func TestAccessTokenResponseOffline(t *testing.T) {
+	token := AccessTokenResponse{AccessToken: "footoken", Scope: "read_products"}
+	if token.IsOnline() {
+		t.Errorf("AccessTokenResponse.IsOnline() = true, expected false")
+	}
+	if !token.ExpiresAt(time.Now()).IsZero() {
+		t.Errorf("AccessTokenResponse.ExpiresAt() = %s, expected zero time", token.ExpiresAt(time.Now()))
+	}
+}
+
+func TestAppGetAccessTokenResponseInvalidShop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// app.Client isn't specified so the shop name is validated
+	for _, shopName := range []string{"evil.com", "evil.com/?fooshop.myshopify.com", "foo shop"} {
+		token, err := app.GetAccessTokenResponse(shopName, "foocode")
+		if err == nil || !strings.Contains(err.Error(), "invalid shop domain") {
+			t.Errorf("App.GetAccessTokenResponse(%q) returned error %v, expected an invalid shop domain error", shopName, err)
+		}
+		if token != nil {
+			t.Errorf("App.GetAccessTokenResponse(%q) returned token %+v, expected nil", shopName, token)
+		}
+	}
+}
+
+func TestAppTokenExchange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/oauth/access_token",
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]string{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			expected := map[string]string{
+				"client_id":            "apikey",
+				"client_secret":        "hush",
+				"grant_type":           "urn:ietf:params:oauth:grant-type:token-exchange",
+				"subject_token":        "sessiontoken",
+				"subject_token_type":   "urn:ietf:params:oauth:token-type:id_token",
+				"requested_token_type": "urn:shopify:params:oauth:token-type:offline-access-token",
+			}
+			if !reflect.DeepEqual(body, expected) {
+				return httpmock.NewStringResponse(400, `{"error":"invalid_request"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"access_token":"footoken","scope":"read_products"}`), nil
+		})
+
+	app.Client = client
+	token, err := app.TokenExchange("fooshop", "sessiontoken", OfflineAccessToken)
+	if err != nil {
+		t.Fatalf("App.TokenExchange(): %v", err)
+	}
+
+	expected := &AccessTokenResponse{AccessToken: "footoken", Scope: "read_products"}
+	if !reflect.DeepEqual(token, expected) {
+		t.Errorf("App.TokenExchange() returned %+v, expected %+v", token, expected)
+	}
+}
+
+func TestAppTokenExchangeError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/admin/oauth/access_token",
+		httpmock.NewStringResponder(400, `{"error":"invalid_subject_token"}`))
+
+	app.Client = client
+	token, err := app.TokenExchange("fooshop", "badtoken", OnlineAccessToken)
+	if err == nil || err.Error() != "invalid_subject_token" {
+		t.Errorf("App.TokenExchange() returned error %v, expected invalid_subject_token", err)
+	}
+	if token != nil {
+		t.Errorf("App.TokenExchange() returned token %+v, expected nil", token)
+	}
+}
+
+func TestAppTokenExchangeInvalidShop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// app.Client isn't specified so the shop name is validated
+	token, err := app.TokenExchange("evil.com/?fooshop.myshopify.com", "sessiontoken", OfflineAccessToken)
+	if err == nil || !strings.Contains(err.Error(), "invalid shop domain") {
+		t.Errorf("App.TokenExchange() returned error %v, expected an invalid shop domain error", err)
+	}
+	if token != nil {
+		t.Errorf("App.TokenExchange() returned token %+v, expected nil", token)
+	}
+}
+
 func TestAppGetAccessTokenError(t *testing.T) {
 	setup()
 	defer teardown()
@@ -261,3 +414,52 @@ func TestVerifyWebhookRequestVerbose(t *testing.T) {
 	}
 
 }
+
+func TestSignWebhook(t *testing.T) {
+	setup()
+	defer teardown()
+
+	expected := "hMTq0K2x7oyOjoBwGYeTj5oxfnaVYXzbanUG9aajpKI="
+	if actual := app.SignWebhook([]byte(`"my secret message"`)); actual != expected {
+		t.Errorf("App.SignWebhook() = %s, expected %s", actual, expected)
+	}
+}
+
+func TestNewTestWebhookRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := []byte(`{"id":1}`)
+	triggeredAt := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	req, err := app.NewTestWebhookRequest("http://localhost:8080/webhooks", TestWebhook{
+		Topic:       "orders/create",
+		ShopDomain:  "fooshop.myshopify.com",
+		ApiVersion:  testApiVersion,
+		TriggeredAt: triggeredAt,
+		Body:        body,
+	})
+	if err != nil {
+		t.Fatalf("App.NewTestWebhookRequest() returned error: %v", err)
+	}
+
+	expectedHeaders := map[string]string{
+		"X-Shopify-Topic":        "orders/create",
+		"X-Shopify-Shop-Domain":  "fooshop.myshopify.com",
+		"X-Shopify-API-Version":  testApiVersion,
+		"X-Shopify-Triggered-At": "2023-01-01T12:00:00Z",
+		"Content-Type":           "application/json",
+	}
+	for k, v := range expectedHeaders {
+		if actual := req.Header.Get(k); actual != v {
+			t.Errorf("App.NewTestWebhookRequest() header %s = %s, expected %s", k, actual, v)
+		}
+	}
+	if req.Header.Get("X-Shopify-Webhook-Id") == "" {
+		t.Errorf("App.NewTestWebhookRequest() did not set X-Shopify-Webhook-Id")
+	}
+
+	ok, err := app.VerifyWebhookRequestVerbose(req)
+	if !ok || err != nil {
+		t.Errorf("App.VerifyWebhookRequestVerbose() on test webhook returned %v, %v", ok, err)
+	}
+}
@@ -3,6 +3,7 @@ package goshopify
 import (
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // Option is used to configure client with options
@@ -40,3 +41,21 @@ func WithHTTPClient(client *http.Client) Option {
 		c.Client = client
 	}
 }
+
+// WithTokenExpiry sets the time at which an online access token expires.
+// Once it has passed, requests fail with an AccessTokenExpiredError instead of
+// being sent to Shopify. See AccessTokenResponse.ExpiresAt
+func WithTokenExpiry(expiresAt time.Time) Option {
+	return func(c *Client) {
+		c.tokenExpiresAt = expiresAt
+	}
+}
+
+// WithAllowedShopDomains allows NewClientE to create clients for shops on
+// other domains than myshopify.com, e.g. "myshopify.io" for custom test
+// hosts. See ValidateShopDomain
+func WithAllowedShopDomains(domains ...string) Option {
+	return func(c *Client) {
+		c.allowedShopDomains = domains
+	}
+}
@@ -72,3 +72,12 @@ func TestWithHTTPClient(t *testing.T) {
 		t.Errorf("WithVersion client.Client = %s, expected %s", c.Client.Timeout, expected)
 	}
 }
+
+func TestWithTokenExpiry(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewClient(app, "fooshop", "abcd", WithTokenExpiry(expiresAt))
+
+	if !c.tokenExpiresAt.Equal(expiresAt) {
+		t.Errorf("WithTokenExpiry client.tokenExpiresAt = %s, expected %s", c.tokenExpiresAt, expiresAt)
+	}
+}
@@ -29,6 +29,9 @@ type OrderService interface {
 	// MetafieldsService used for Order resource to communicate with Metafields resource
 	MetafieldsService
 
+	// EventsService used for Order resource to communicate with Events resource
+	EventsService
+
 	// FulfillmentsService used for Order resource to communicate with Fulfillments resource
 	FulfillmentsService
 }
@@ -455,6 +458,10 @@ type Transaction struct {
 	SourceName     string           `json:"source_name,omitempty"`
 	Source         string           `json:"source,omitempty"`
 	PaymentDetails *PaymentDetails  `json:"payment_details,omitempty"`
+
+	// MaximumRefundable is set on the suggested transactions of a refund
+	// calculation
+	MaximumRefundable *decimal.Decimal `json:"maximum_refundable,omitempty"`
 }
 
 type ClientDetails struct {
@@ -467,15 +474,29 @@ type ClientDetails struct {
 }
 
 type Refund struct {
-	Id               int64             `json:"id,omitempty"`
-	OrderId          int64             `json:"order_id,omitempty"`
-	CreatedAt        *time.Time        `json:"created_at,omitempty"`
-	Note             string            `json:"note,omitempty"`
-	Restock          bool              `json:"restock,omitempty"`
-	UserId           int64             `json:"user_id,omitempty"`
-	RefundLineItems  []RefundLineItem  `json:"refund_line_items,omitempty"`
-	Transactions     []Transaction     `json:"transactions,omitempty"`
-	OrderAdjustments []OrderAdjustment `json:"order_adjustments,omitempty"`
+	Id                int64             `json:"id,omitempty"`
+	OrderId           int64             `json:"order_id,omitempty"`
+	CreatedAt         *time.Time        `json:"created_at,omitempty"`
+	ProcessedAt       *time.Time        `json:"processed_at,omitempty"`
+	Note              string            `json:"note,omitempty"`
+	Restock           bool              `json:"restock,omitempty"`
+	Notify            bool              `json:"notify,omitempty"`
+	Currency          string            `json:"currency,omitempty"`
+	DiscrepancyReason string            `json:"discrepancy_reason,omitempty"`
+	UserId            int64             `json:"user_id,omitempty"`
+	Shipping          *RefundShipping   `json:"shipping,omitempty"`
+	RefundLineItems   []RefundLineItem  `json:"refund_line_items,omitempty"`
+	Transactions      []Transaction     `json:"transactions,omitempty"`
+	OrderAdjustments  []OrderAdjustment `json:"order_adjustments,omitempty"`
+}
+
+// RefundShipping is the shipping amount to refund. When calculating a refund,
+// set FullRefund or Amount, the response has the maximum refundable amount.
+type RefundShipping struct {
+	FullRefund        bool             `json:"full_refund,omitempty"`
+	Amount            *decimal.Decimal `json:"amount,omitempty"`
+	Tax               *decimal.Decimal `json:"tax,omitempty"`
+	MaximumRefundable *decimal.Decimal `json:"maximum_refundable,omitempty"`
 }
 
 type OrderAdjustment struct {
@@ -498,13 +519,36 @@ const (
 )
 
 type RefundLineItem struct {
-	Id         int64            `json:"id,omitempty"`
-	Quantity   int              `json:"quantity,omitempty"`
-	LineItemId int64            `json:"line_item_id,omitempty"`
-	LineItem   *LineItem        `json:"line_item,omitempty"`
-	Subtotal   *decimal.Decimal `json:"subtotal,omitempty"`
-	TotalTax   *decimal.Decimal `json:"total_tax,omitempty"`
-}
+	Id          int64             `json:"id,omitempty"`
+	Quantity    int               `json:"quantity,omitempty"`
+	LineItemId  int64             `json:"line_item_id,omitempty"`
+	LineItem    *LineItem         `json:"line_item,omitempty"`
+	RestockType RefundRestockType `json:"restock_type,omitempty"`
+	LocationId  *int64            `json:"location_id,omitempty"`
+	Price       *decimal.Decimal  `json:"price,omitempty"`
+	Subtotal    *decimal.Decimal  `json:"subtotal,omitempty"`
+	TotalTax    *decimal.Decimal  `json:"total_tax,omitempty"`
+	SubtotalSet *AmountSet        `json:"subtotal_set,omitempty"`
+	TotalTaxSet *AmountSet        `json:"total_tax_set,omitempty"`
+}
+
+// RefundRestockType is how the items of a refund line item affect inventory
+type RefundRestockType string
+
+const (
+	// The items are not restocked
+	RefundRestockTypeNoRestock RefundRestockType = "no_restock"
+
+	// The items were not fulfilled and are restocked at the location
+	RefundRestockTypeCancel RefundRestockType = "cancel"
+
+	// The items were fulfilled and returned to the location
+	RefundRestockTypeReturn RefundRestockType = "return"
+
+	// The items are restocked at their original location, only on refunds
+	// created before restock types existed
+	RefundRestockTypeLegacyRestock RefundRestockType = "legacy_restock"
+)
 
 // List orders
 func (s *OrderServiceOp) List(options interface{}) ([]Order, error) {
@@ -673,3 +717,15 @@ func (s *OrderServiceOp) CancelFulfillment(orderID int64, fulfillmentID int64) (
 	fulfillmentService := &FulfillmentServiceOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
 	return fulfillmentService.Cancel(fulfillmentID)
 }
+
+// ListEvents for a order
+func (s *OrderServiceOp) ListEvents(orderID int64, options interface{}) ([]Event, error) {
+	eventService := &EventServiceOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
+	return eventService.List(options)
+}
+
+// ListEventsWithPagination for a order
+func (s *OrderServiceOp) ListEventsWithPagination(orderID int64, options interface{}) ([]Event, *Pagination, error) {
+	eventService := &EventServiceOp{client: s.client, resource: ordersResourceName, resourceID: orderID}
+	return eventService.ListWithPagination(options)
+}
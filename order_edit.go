@@ -0,0 +1,446 @@
+package goshopify
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// OrderEditService is an interface for editing placed orders with the order
+// editing mutations of the GraphQL Admin API.
+//
+// An edit is staged on a calculated order returned by Begin: AddVariant,
+// SetQuantity and AddLineItemDiscount stage changes and return a preview of
+// the edited order, and Commit applies them. An edit that is never committed
+// is discarded.
+// See: https://shopify.dev/docs/apps/fulfillment/order-management-apps/order-editing
+type OrderEditService interface {
+	Begin(int64) (*CalculatedOrder, error)
+	AddVariant(string, OrderEditVariant) (*CalculatedOrder, error)
+	SetQuantity(string, string, int, bool) (*CalculatedOrder, error)
+	AddLineItemDiscount(string, string, OrderEditDiscount) (*CalculatedOrder, error)
+	Commit(string, OrderEditCommitOptions) (*Order, error)
+}
+
+// OrderEditServiceOp handles communication with the order editing mutations
+// of the Shopify API.
+type OrderEditServiceOp struct {
+	client *Client
+}
+
+// CalculatedOrder is an order with staged edits. Its ID identifies the edit
+// session in the other methods of OrderEditService. LineItems and
+// AddedLineItems hold every line item, fetched in pages of 50.
+type CalculatedOrder struct {
+	ID                        string               `json:"id,omitempty"`
+	OriginalOrderID           int64                `json:"original_order_id,omitempty"`
+	SubtotalLineItemsQuantity int                  `json:"subtotal_line_items_quantity,omitempty"`
+	SubtotalPriceSet          *AmountSet           `json:"subtotal_price_set,omitempty"`
+	CartDiscountAmountSet     *AmountSet           `json:"cart_discount_amount_set,omitempty"`
+	TotalPriceSet             *AmountSet           `json:"total_price_set,omitempty"`
+	TotalOutstandingSet       *AmountSet           `json:"total_outstanding_set,omitempty"`
+	LineItems                 []CalculatedLineItem `json:"line_items,omitempty"`
+	AddedLineItems            []CalculatedLineItem `json:"added_line_items,omitempty"`
+}
+
+// CalculatedLineItem is a line item of a calculated order. Its ID is the one
+// to pass to SetQuantity and AddLineItemDiscount.
+type CalculatedLineItem struct {
+	ID                     string     `json:"id,omitempty"`
+	VariantID              int64      `json:"variant_id,omitempty"`
+	Title                  string     `json:"title,omitempty"`
+	SKU                    string     `json:"sku,omitempty"`
+	Quantity               int        `json:"quantity,omitempty"`
+	EditableQuantity       int        `json:"editable_quantity,omitempty"`
+	Restockable            bool       `json:"restockable,omitempty"`
+	Restocking             bool       `json:"restocking,omitempty"`
+	OriginalUnitPriceSet   *AmountSet `json:"original_unit_price_set,omitempty"`
+	DiscountedUnitPriceSet *AmountSet `json:"discounted_unit_price_set,omitempty"`
+}
+
+// LineItemForVariant returns the line item of the variant, looking in the
+// added line items first, or nil if the order has none.
+func (o *CalculatedOrder) LineItemForVariant(variantID int64) *CalculatedLineItem {
+	for _, items := range [][]CalculatedLineItem{o.AddedLineItems, o.LineItems} {
+		for i := range items {
+			if items[i].VariantID == variantID {
+				return &items[i]
+			}
+		}
+	}
+	return nil
+}
+
+// OrderEditVariant is a variant to add to an order
+type OrderEditVariant struct {
+	VariantID int64
+	Quantity  int
+
+	// LocationID is the location to check for inventory, defaults to the
+	// location chosen by Shopify
+	LocationID int64
+
+	// AllowDuplicates adds a new line item even if the order already has one
+	// for the variant
+	AllowDuplicates bool
+}
+
+// OrderEditDiscount is a discount applied to a line item of an order. Set
+// either Percent or FixedAmount with its CurrencyCode.
+type OrderEditDiscount struct {
+	Description  string
+	Percent      *decimal.Decimal
+	FixedAmount  *decimal.Decimal
+	CurrencyCode string
+}
+
+// OrderEditCommitOptions are the options of OrderEditService.Commit
+type OrderEditCommitOptions struct {
+	NotifyCustomer bool
+	StaffNote      string
+}
+
+const calculatedLineItemFragment = `
+fragment calculatedLineItemFields on CalculatedLineItem {
+  id
+  title
+  sku
+  quantity
+  editableQuantity
+  restockable
+  restocking
+  variant { legacyResourceId }
+  originalUnitPriceSet { ...moneyBagFields }
+  discountedUnitPriceSet { ...moneyBagFields }
+}
+
+fragment moneyBagFields on MoneyBag {
+  shopMoney { amount currencyCode }
+  presentmentMoney { amount currencyCode }
+}
+`
+
+// calculatedOrderFragment fetches the first 50 line items and added line
+// items, which keeps the requested cost of a query under Shopify's limit of
+// 1000 points. The other pages are fetched with calculatedOrderLineItemsQuery
+// and calculatedOrderAddedLineItemsQuery.
+const calculatedOrderFragment = `
+fragment calculatedOrderFields on CalculatedOrder {
+  id
+  originalOrder { legacyResourceId }
+  subtotalLineItemsQuantity
+  subtotalPriceSet { ...moneyBagFields }
+  cartDiscountAmountSet { ...moneyBagFields }
+  totalPriceSet { ...moneyBagFields }
+  totalOutstandingSet { ...moneyBagFields }
+  lineItems(first: 50) {
+    edges { node { ...calculatedLineItemFields } }
+    pageInfo { hasNextPage endCursor }
+  }
+  addedLineItems(first: 50) {
+    edges { node { ...calculatedLineItemFields } }
+    pageInfo { hasNextPage endCursor }
+  }
+}
+` + calculatedLineItemFragment
+
+const calculatedOrderLineItemsQuery = `
+query calculatedOrderLineItems($id: ID!, $after: String) {
+  node(id: $id) {
+    ... on CalculatedOrder {
+      lineItems(first: 50, after: $after) {
+        edges { node { ...calculatedLineItemFields } }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}
+` + calculatedLineItemFragment
+
+const calculatedOrderAddedLineItemsQuery = `
+query calculatedOrderAddedLineItems($id: ID!, $after: String) {
+  node(id: $id) {
+    ... on CalculatedOrder {
+      addedLineItems(first: 50, after: $after) {
+        edges { node { ...calculatedLineItemFields } }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}
+` + calculatedLineItemFragment
+
+const orderEditBeginMutation = `
+mutation orderEditBegin($id: ID!) {
+  orderEditBegin(id: $id) {
+    calculatedOrder { ...calculatedOrderFields }
+    userErrors { field message }
+  }
+}
+` + calculatedOrderFragment
+
+const orderEditAddVariantMutation = `
+mutation orderEditAddVariant($id: ID!, $variantId: ID!, $quantity: Int!, $locationId: ID, $allowDuplicates: Boolean) {
+  orderEditAddVariant(id: $id, variantId: $variantId, quantity: $quantity, locationId: $locationId, allowDuplicates: $allowDuplicates) {
+    calculatedOrder { ...calculatedOrderFields }
+    userErrors { field message }
+  }
+}
+` + calculatedOrderFragment
+
+const orderEditSetQuantityMutation = `
+mutation orderEditSetQuantity($id: ID!, $lineItemId: ID!, $quantity: Int!, $restock: Boolean) {
+  orderEditSetQuantity(id: $id, lineItemId: $lineItemId, quantity: $quantity, restock: $restock) {
+    calculatedOrder { ...calculatedOrderFields }
+    userErrors { field message }
+  }
+}
+` + calculatedOrderFragment
+
+const orderEditAddLineItemDiscountMutation = `
+mutation orderEditAddLineItemDiscount($id: ID!, $lineItemId: ID!, $discount: OrderEditAppliedDiscountInput!) {
+  orderEditAddLineItemDiscount(id: $id, lineItemId: $lineItemId, discount: $discount) {
+    calculatedOrder { ...calculatedOrderFields }
+    userErrors { field message }
+  }
+}
+` + calculatedOrderFragment
+
+const orderEditCommitMutation = `
+mutation orderEditCommit($id: ID!, $notifyCustomer: Boolean, $staffNote: String) {
+  orderEditCommit(id: $id, notifyCustomer: $notifyCustomer, staffNote: $staffNote) {
+    order { legacyResourceId name }
+    userErrors { field message }
+  }
+}
+`
+
+type graphQLCalculatedLineItem struct {
+	ID                     string                 `json:"id"`
+	Title                  string                 `json:"title"`
+	SKU                    string                 `json:"sku"`
+	Quantity               int                    `json:"quantity"`
+	EditableQuantity       int                    `json:"editableQuantity"`
+	Restockable            bool                   `json:"restockable"`
+	Restocking             bool                   `json:"restocking"`
+	Variant                *graphQLLegacyResource `json:"variant"`
+	OriginalUnitPriceSet   *graphQLMoneyBag       `json:"originalUnitPriceSet"`
+	DiscountedUnitPriceSet *graphQLMoneyBag       `json:"discountedUnitPriceSet"`
+}
+
+type graphQLCalculatedLineItems struct {
+	Edges []struct {
+		Node graphQLCalculatedLineItem `json:"node"`
+	} `json:"edges"`
+	PageInfo graphQLPageInfo `json:"pageInfo"`
+}
+
+type graphQLCalculatedOrder struct {
+	ID                        string                     `json:"id"`
+	OriginalOrder             *graphQLLegacyResource     `json:"originalOrder"`
+	SubtotalLineItemsQuantity int                        `json:"subtotalLineItemsQuantity"`
+	SubtotalPriceSet          *graphQLMoneyBag           `json:"subtotalPriceSet"`
+	CartDiscountAmountSet     *graphQLMoneyBag           `json:"cartDiscountAmountSet"`
+	TotalPriceSet             *graphQLMoneyBag           `json:"totalPriceSet"`
+	TotalOutstandingSet       *graphQLMoneyBag           `json:"totalOutstandingSet"`
+	LineItems                 graphQLCalculatedLineItems `json:"lineItems"`
+	AddedLineItems            graphQLCalculatedLineItems `json:"addedLineItems"`
+}
+
+// orderEditPayload is the payload of the order editing mutations returning a
+// calculated order
+type orderEditPayload struct {
+	CalculatedOrder *graphQLCalculatedOrder `json:"calculatedOrder"`
+	UserErrors      []graphQLUserError      `json:"userErrors"`
+}
+
+func (i graphQLCalculatedLineItem) calculatedLineItem() CalculatedLineItem {
+	item := CalculatedLineItem{
+		ID:                     i.ID,
+		Title:                  i.Title,
+		SKU:                    i.SKU,
+		Quantity:               i.Quantity,
+		EditableQuantity:       i.EditableQuantity,
+		Restockable:            i.Restockable,
+		Restocking:             i.Restocking,
+		OriginalUnitPriceSet:   i.OriginalUnitPriceSet.amountSet(),
+		DiscountedUnitPriceSet: i.DiscountedUnitPriceSet.amountSet(),
+	}
+	if i.Variant != nil {
+		item.VariantID = i.Variant.LegacyResourceID
+	}
+	return item
+}
+
+func (l graphQLCalculatedLineItems) calculatedLineItems() []CalculatedLineItem {
+	items := make([]CalculatedLineItem, 0, len(l.Edges))
+	for _, edge := range l.Edges {
+		items = append(items, edge.Node.calculatedLineItem())
+	}
+	return items
+}
+
+func (o *graphQLCalculatedOrder) calculatedOrder() *CalculatedOrder {
+	if o == nil {
+		return nil
+	}
+	order := &CalculatedOrder{
+		ID:                        o.ID,
+		SubtotalLineItemsQuantity: o.SubtotalLineItemsQuantity,
+		SubtotalPriceSet:          o.SubtotalPriceSet.amountSet(),
+		CartDiscountAmountSet:     o.CartDiscountAmountSet.amountSet(),
+		TotalPriceSet:             o.TotalPriceSet.amountSet(),
+		TotalOutstandingSet:       o.TotalOutstandingSet.amountSet(),
+		LineItems:                 o.LineItems.calculatedLineItems(),
+		AddedLineItems:            o.AddedLineItems.calculatedLineItems(),
+	}
+	if o.OriginalOrder != nil {
+		order.OriginalOrderID = o.OriginalOrder.LegacyResourceID
+	}
+	return order
+}
+
+// mutate runs an order editing mutation returning a calculated order
+func (s *OrderEditServiceOp) mutate(name, mutation string, vars map[string]interface{}) (*CalculatedOrder, error) {
+	resp := map[string]*orderEditPayload{}
+	if err := s.client.GraphQL.Query(mutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	payload := resp[name]
+	if payload == nil {
+		return nil, ResponseError{Status: 200, Message: "missing " + name + " in response"}
+	}
+	if err := userErrorsToError(payload.UserErrors); err != nil {
+		return nil, err
+	}
+
+	if order := payload.CalculatedOrder; order != nil {
+		lineItems := func(o *graphQLCalculatedOrder) *graphQLCalculatedLineItems { return &o.LineItems }
+		if err := s.nextLineItems(order, calculatedOrderLineItemsQuery, lineItems); err != nil {
+			return nil, err
+		}
+		addedLineItems := func(o *graphQLCalculatedOrder) *graphQLCalculatedLineItems { return &o.AddedLineItems }
+		if err := s.nextLineItems(order, calculatedOrderAddedLineItemsQuery, addedLineItems); err != nil {
+			return nil, err
+		}
+	}
+	return payload.CalculatedOrder.calculatedOrder(), nil
+}
+
+// nextLineItems fetches the pages of line items of the calculated order that
+// follow the first one, query fetching the connection returned by items
+func (s *OrderEditServiceOp) nextLineItems(order *graphQLCalculatedOrder, query string, items func(*graphQLCalculatedOrder) *graphQLCalculatedLineItems) error {
+	connection := items(order)
+	for connection.PageInfo.HasNextPage {
+		resp := struct {
+			Node *graphQLCalculatedOrder `json:"node"`
+		}{}
+		vars := map[string]interface{}{
+			"id":    order.ID,
+			"after": connection.PageInfo.EndCursor,
+		}
+		if err := s.client.GraphQL.Query(query, vars, &resp); err != nil {
+			return err
+		}
+		if resp.Node == nil {
+			return ResponseError{Status: 200, Message: "missing calculated order " + order.ID + " in response"}
+		}
+
+		page := items(resp.Node)
+		if page.PageInfo.HasNextPage && page.PageInfo.EndCursor == connection.PageInfo.EndCursor {
+			return ResponseError{Status: 200, Message: "line items of calculated order " + order.ID + " did not advance"}
+		}
+		connection.Edges = append(connection.Edges, page.Edges...)
+		connection.PageInfo = page.PageInfo
+	}
+	return nil
+}
+
+// Begin an edit of the order
+func (s *OrderEditServiceOp) Begin(orderID int64) (*CalculatedOrder, error) {
+	return s.mutate("orderEditBegin", orderEditBeginMutation, map[string]interface{}{
+		"id": graphQLID("Order", orderID),
+	})
+}
+
+// AddVariant stages the addition of a variant to the order
+func (s *OrderEditServiceOp) AddVariant(calculatedOrderID string, variant OrderEditVariant) (*CalculatedOrder, error) {
+	vars := map[string]interface{}{
+		"id":              calculatedOrderID,
+		"variantId":       graphQLID("ProductVariant", variant.VariantID),
+		"quantity":        variant.Quantity,
+		"allowDuplicates": variant.AllowDuplicates,
+	}
+	if variant.LocationID != 0 {
+		vars["locationId"] = graphQLID("Location", variant.LocationID)
+	}
+	return s.mutate("orderEditAddVariant", orderEditAddVariantMutation, vars)
+}
+
+// SetQuantity stages a new quantity for a line item, 0 removes it. Removed
+// items are restocked if restock is set.
+func (s *OrderEditServiceOp) SetQuantity(calculatedOrderID string, lineItemID string, quantity int, restock bool) (*CalculatedOrder, error) {
+	return s.mutate("orderEditSetQuantity", orderEditSetQuantityMutation, map[string]interface{}{
+		"id":         calculatedOrderID,
+		"lineItemId": lineItemID,
+		"quantity":   quantity,
+		"restock":    restock,
+	})
+}
+
+// AddLineItemDiscount stages a discount on a line item added by the edit
+func (s *OrderEditServiceOp) AddLineItemDiscount(calculatedOrderID string, lineItemID string, discount OrderEditDiscount) (*CalculatedOrder, error) {
+	input := map[string]interface{}{}
+	if discount.Description != "" {
+		input["description"] = discount.Description
+	}
+	if discount.Percent != nil {
+		percent, _ := discount.Percent.Float64()
+		input["percentValue"] = percent
+	}
+	if discount.FixedAmount != nil {
+		input["fixedValue"] = map[string]interface{}{
+			"amount":       discount.FixedAmount.String(),
+			"currencyCode": discount.CurrencyCode,
+		}
+	}
+
+	return s.mutate("orderEditAddLineItemDiscount", orderEditAddLineItemDiscountMutation, map[string]interface{}{
+		"id":         calculatedOrderID,
+		"lineItemId": lineItemID,
+		"discount":   input,
+	})
+}
+
+// Commit applies the staged changes to the order. The returned order only has
+// its ID and Name set.
+func (s *OrderEditServiceOp) Commit(calculatedOrderID string, options OrderEditCommitOptions) (*Order, error) {
+	resp := struct {
+		OrderEditCommit struct {
+			Order *struct {
+				LegacyResourceID int64  `json:"legacyResourceId,string"`
+				Name             string `json:"name"`
+			} `json:"order"`
+			UserErrors []graphQLUserError `json:"userErrors"`
+		} `json:"orderEditCommit"`
+	}{}
+
+	vars := map[string]interface{}{
+		"id":             calculatedOrderID,
+		"notifyCustomer": options.NotifyCustomer,
+	}
+	if options.StaffNote != "" {
+		vars["staffNote"] = options.StaffNote
+	}
+	if err := s.client.GraphQL.Query(orderEditCommitMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	payload := resp.OrderEditCommit
+	if err := userErrorsToError(payload.UserErrors); err != nil {
+		return nil, err
+	}
+	if payload.Order == nil {
+		return nil, ResponseError{Status: 200, Message: "missing order in orderEditCommit response"}
+	}
+	return &Order{ID: payload.Order.LegacyResourceID, Name: payload.Order.Name}, nil
+}
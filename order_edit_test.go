@@ -0,0 +1,289 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+// graphQLRequest is the body of a request to the graphql endpoint
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// registerGraphQLResponder responds to graphql requests with the given body
+// and stores the last request in req
+func registerGraphQLResponder(req *graphQLRequest, body []byte) {
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(r *http.Request) (*http.Response, error) {
+			*req = graphQLRequest{}
+			if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(200, body), nil
+		})
+}
+
+func TestOrderEditBegin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var req graphQLRequest
+	registerGraphQLResponder(&req, loadFixture("order_edit_begin.json"))
+
+	order, err := client.OrderEdit.Begin(450789469)
+	if err != nil {
+		t.Fatalf("OrderEdit.Begin returned error: %v", err)
+	}
+
+	if !strings.Contains(req.Query, "orderEditBegin(id: $id)") {
+		t.Errorf("OrderEdit.Begin sent query %s", req.Query)
+	}
+	// larger pages exceed the query cost limit of 1000 points
+	for _, connection := range []string{" lineItems(first: 50)", " addedLineItems(first: 50)"} {
+		if !strings.Contains(req.Query, connection) {
+			t.Errorf("OrderEdit.Begin sent query without%s: %s", connection, req.Query)
+		}
+	}
+	if req.Variables["id"] != "gid://shopify/Order/450789469" {
+		t.Errorf("OrderEdit.Begin sent id %v, expected gid://shopify/Order/450789469", req.Variables["id"])
+	}
+
+	total := decimal.NewFromFloat(209)
+	expected := &CalculatedOrder{
+		ID:                        "gid://shopify/CalculatedOrder/607673084",
+		OriginalOrderID:           450789469,
+		SubtotalLineItemsQuantity: 1,
+		LineItems: []CalculatedLineItem{
+			{
+				ID:               "gid://shopify/CalculatedLineItem/466157049",
+				VariantID:        39072856,
+				Title:            "IPod Nano - 8gb",
+				SKU:              "IPOD2008GREEN",
+				Quantity:         1,
+				EditableQuantity: 1,
+				Restockable:      true,
+			},
+		},
+		AddedLineItems: []CalculatedLineItem{},
+	}
+	if order.TotalPriceSet == nil || !order.TotalPriceSet.ShopMoney.Amount.Equal(total) || order.TotalPriceSet.ShopMoney.CurrencyCode != "USD" {
+		t.Errorf("CalculatedOrder.TotalPriceSet returned %+v, expected %v USD", order.TotalPriceSet, total)
+	}
+
+	// prices are checked above, compare the other fields
+	order.SubtotalPriceSet, order.CartDiscountAmountSet, order.TotalPriceSet, order.TotalOutstandingSet = nil, nil, nil, nil
+	for i := range order.LineItems {
+		order.LineItems[i].OriginalUnitPriceSet, order.LineItems[i].DiscountedUnitPriceSet = nil, nil
+	}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("OrderEdit.Begin returned %+v, expected %+v", order, expected)
+	}
+}
+
+func TestOrderEditLineItemPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	lineItem := func(id, variantID int) string {
+		return fmt.Sprintf(`{"node":{"id":"gid://shopify/CalculatedLineItem/%d","quantity":1,"variant":{"legacyResourceId":"%d"}}}`, id, variantID)
+	}
+	responses := map[string]string{
+		"orderEditBegin": `{"data":{"orderEditBegin":{"calculatedOrder":{"id":"gid://shopify/CalculatedOrder/607673084",` +
+			`"lineItems":{"edges":[` + lineItem(1, 101) + `],"pageInfo":{"hasNextPage":true,"endCursor":"cursor1"}},` +
+			`"addedLineItems":{"edges":[],"pageInfo":{"hasNextPage":false}}},"userErrors":[]}}}`,
+		"calculatedOrderLineItems:cursor1": `{"data":{"node":{"lineItems":{"edges":[` + lineItem(2, 102) + `],"pageInfo":{"hasNextPage":true,"endCursor":"cursor2"}}}}}`,
+		"calculatedOrderLineItems:cursor2": `{"data":{"node":{"lineItems":{"edges":[` + lineItem(3, 103) + `],"pageInfo":{"hasNextPage":false,"endCursor":"cursor3"}}}}}`,
+	}
+
+	var requests []string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(r *http.Request) (*http.Response, error) {
+			req := graphQLRequest{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				return nil, err
+			}
+			key := "orderEditBegin"
+			if strings.Contains(req.Query, "query calculatedOrderLineItems(") {
+				if req.Variables["id"] != "gid://shopify/CalculatedOrder/607673084" {
+					t.Errorf("OrderEdit.Begin sent line items query for %v", req.Variables["id"])
+				}
+				key = fmt.Sprintf("calculatedOrderLineItems:%v", req.Variables["after"])
+			}
+			requests = append(requests, key)
+			body, ok := responses[key]
+			if !ok {
+				return httpmock.NewStringResponse(400, `{"errors":"unexpected request"}`), nil
+			}
+			return httpmock.NewStringResponse(200, body), nil
+		})
+
+	order, err := client.OrderEdit.Begin(450789469)
+	if err != nil {
+		t.Fatalf("OrderEdit.Begin returned error: %v", err)
+	}
+
+	expectedRequests := []string{"orderEditBegin", "calculatedOrderLineItems:cursor1", "calculatedOrderLineItems:cursor2"}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("OrderEdit.Begin sent requests %v, expected %v", requests, expectedRequests)
+	}
+	if len(order.LineItems) != 3 {
+		t.Fatalf("OrderEdit.Begin returned %d line items, expected 3", len(order.LineItems))
+	}
+	if item := order.LineItemForVariant(103); item == nil || item.ID != "gid://shopify/CalculatedLineItem/3" {
+		t.Errorf("CalculatedOrder.LineItemForVariant(103) returned %+v, expected the line item of the last page", item)
+	}
+}
+
+func TestOrderEditAddVariant(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var req graphQLRequest
+	registerGraphQLResponder(&req, loadFixture("order_edit_add_variant.json"))
+
+	order, err := client.OrderEdit.AddVariant("gid://shopify/CalculatedOrder/607673084", OrderEditVariant{
+		VariantID:  457924702,
+		Quantity:   2,
+		LocationID: 487838322,
+	})
+	if err != nil {
+		t.Fatalf("OrderEdit.AddVariant returned error: %v", err)
+	}
+
+	expectedVars := map[string]interface{}{
+		"id":              "gid://shopify/CalculatedOrder/607673084",
+		"variantId":       "gid://shopify/ProductVariant/457924702",
+		"quantity":        float64(2),
+		"locationId":      "gid://shopify/Location/487838322",
+		"allowDuplicates": false,
+	}
+	if !reflect.DeepEqual(req.Variables, expectedVars) {
+		t.Errorf("OrderEdit.AddVariant sent variables %+v, expected %+v", req.Variables, expectedVars)
+	}
+
+	added := order.LineItemForVariant(457924702)
+	if added == nil || added.ID != "gid://shopify/CalculatedLineItem/4f8a2c4e-0a4d-4d3e-9b1c-0a6f3b0e7d21" || added.Quantity != 2 {
+		t.Errorf("CalculatedOrder.LineItemForVariant returned %+v, expected the added line item", added)
+	}
+	if order.LineItemForVariant(39072856) == nil {
+		t.Errorf("CalculatedOrder.LineItemForVariant returned no original line item")
+	}
+	if order.LineItemForVariant(1) != nil {
+		t.Errorf("CalculatedOrder.LineItemForVariant returned a line item for an unknown variant")
+	}
+
+	outstanding := decimal.NewFromFloat(398)
+	if !order.TotalOutstandingSet.ShopMoney.Amount.Equal(outstanding) {
+		t.Errorf("CalculatedOrder.TotalOutstandingSet returned %+v, expected %v", order.TotalOutstandingSet, outstanding)
+	}
+}
+
+func TestOrderEditSetQuantity(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var req graphQLRequest
+	registerGraphQLResponder(&req, []byte(`{"data":{"orderEditSetQuantity":{"calculatedOrder":{"id":"gid://shopify/CalculatedOrder/607673084","subtotalLineItemsQuantity":0,"lineItems":{"edges":[]},"addedLineItems":{"edges":[]}},"userErrors":[]}}}`))
+
+	order, err := client.OrderEdit.SetQuantity("gid://shopify/CalculatedOrder/607673084", "gid://shopify/CalculatedLineItem/466157049", 0, true)
+	if err != nil {
+		t.Fatalf("OrderEdit.SetQuantity returned error: %v", err)
+	}
+
+	if req.Variables["lineItemId"] != "gid://shopify/CalculatedLineItem/466157049" || req.Variables["quantity"] != float64(0) || req.Variables["restock"] != true {
+		t.Errorf("OrderEdit.SetQuantity sent variables %+v", req.Variables)
+	}
+	if order.ID != "gid://shopify/CalculatedOrder/607673084" || order.SubtotalLineItemsQuantity != 0 {
+		t.Errorf("OrderEdit.SetQuantity returned %+v", order)
+	}
+}
+
+func TestOrderEditAddLineItemDiscount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var req graphQLRequest
+	registerGraphQLResponder(&req, []byte(`{"data":{"orderEditAddLineItemDiscount":{"calculatedOrder":{"id":"gid://shopify/CalculatedOrder/607673084","lineItems":{"edges":[]},"addedLineItems":{"edges":[]}},"userErrors":[]}}}`))
+
+	amount := decimal.NewFromFloat(10.5)
+	_, err := client.OrderEdit.AddLineItemDiscount("gid://shopify/CalculatedOrder/607673084", "gid://shopify/CalculatedLineItem/1", OrderEditDiscount{
+		Description:  "Loyalty",
+		FixedAmount:  &amount,
+		CurrencyCode: "USD",
+	})
+	if err != nil {
+		t.Fatalf("OrderEdit.AddLineItemDiscount returned error: %v", err)
+	}
+
+	expectedDiscount := map[string]interface{}{
+		"description": "Loyalty",
+		"fixedValue":  map[string]interface{}{"amount": "10.5", "currencyCode": "USD"},
+	}
+	if !reflect.DeepEqual(req.Variables["discount"], expectedDiscount) {
+		t.Errorf("OrderEdit.AddLineItemDiscount sent discount %+v, expected %+v", req.Variables["discount"], expectedDiscount)
+	}
+
+	percent := decimal.NewFromFloat(15)
+	_, err = client.OrderEdit.AddLineItemDiscount("gid://shopify/CalculatedOrder/607673084", "gid://shopify/CalculatedLineItem/1", OrderEditDiscount{
+		Percent: &percent,
+	})
+	if err != nil {
+		t.Fatalf("OrderEdit.AddLineItemDiscount returned error: %v", err)
+	}
+	expectedDiscount = map[string]interface{}{"percentValue": float64(15)}
+	if !reflect.DeepEqual(req.Variables["discount"], expectedDiscount) {
+		t.Errorf("OrderEdit.AddLineItemDiscount sent discount %+v, expected %+v", req.Variables["discount"], expectedDiscount)
+	}
+}
+
+func TestOrderEditUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var req graphQLRequest
+	registerGraphQLResponder(&req, []byte(`{"data":{"orderEditSetQuantity":{"calculatedOrder":null,"userErrors":[{"field":["quantity"],"message":"Quantity must be greater than or equal to 0"}]}}}`))
+
+	_, err := client.OrderEdit.SetQuantity("gid://shopify/CalculatedOrder/607673084", "gid://shopify/CalculatedLineItem/1", -1, false)
+	expected := "quantity: Quantity must be greater than or equal to 0"
+	if err == nil || err.Error() != expected {
+		t.Errorf("OrderEdit.SetQuantity returned error %v, expected %s", err, expected)
+	}
+}
+
+func TestOrderEditCommit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var req graphQLRequest
+	registerGraphQLResponder(&req, []byte(`{"data":{"orderEditCommit":{"order":{"legacyResourceId":"450789469","name":"#1001"},"userErrors":[]}}}`))
+
+	order, err := client.OrderEdit.Commit("gid://shopify/CalculatedOrder/607673084", OrderEditCommitOptions{
+		NotifyCustomer: true,
+		StaffNote:      "Added a second iPod",
+	})
+	if err != nil {
+		t.Fatalf("OrderEdit.Commit returned error: %v", err)
+	}
+
+	expectedVars := map[string]interface{}{
+		"id":             "gid://shopify/CalculatedOrder/607673084",
+		"notifyCustomer": true,
+		"staffNote":      "Added a second iPod",
+	}
+	if !reflect.DeepEqual(req.Variables, expectedVars) {
+		t.Errorf("OrderEdit.Commit sent variables %+v, expected %+v", req.Variables, expectedVars)
+	}
+
+	expected := &Order{ID: 450789469, Name: "#1001"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("OrderEdit.Commit returned %+v, expected %+v", order, expected)
+	}
+}
@@ -1353,3 +1353,21 @@ func validShippingLines() ShippingLines {
 		Handle: "test",
 	}
 }
+
+func TestOrderListEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/1/events.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"events": [{"id":1,"verb":"confirmed"},{"id":2,"verb":"placed"}]}`))
+
+	events, _, err := client.Order.ListEventsWithPagination(1, nil)
+	if err != nil {
+		t.Errorf("Order.ListEventsWithPagination() returned error: %v", err)
+	}
+
+	expected := []Event{{ID: 1, Verb: "confirmed"}, {ID: 2, Verb: "placed"}}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Order.ListEventsWithPagination() returned %+v, expected %+v", events, expected)
+	}
+}
@@ -92,6 +92,26 @@ func TestPaymentsTransactionsListIncorrectDate(t *testing.T) {
 	}
 }
 
+func TestPaymentsTransactionsListProcessedAt(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"processed_at": `"2022-02-03"`}
+	httpmock.RegisterResponderWithQuery("GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/balance/transactions.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"transactions": [{"id":1}]}`))
+
+	date := OnlyDate{time.Date(2022, 02, 03, 0, 0, 0, 0, time.UTC)}
+	paymentsTransactions, err := client.PaymentsTransactions.List(PaymentsTransactionsListOptions{ProcessedAt: &date})
+	if err != nil {
+		t.Errorf("PaymentsTransactions.List returned error: %v", err)
+	}
+	if len(paymentsTransactions) != 1 || paymentsTransactions[0].Id != 1 {
+		t.Errorf("PaymentsTransactions.List returned %+v, expected the transaction processed at 2022-02-03", paymentsTransactions)
+	}
+}
+
 func TestPaymentsTransactionsListError(t *testing.T) {
 	setup()
 	defer teardown()
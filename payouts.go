@@ -53,6 +53,10 @@ const (
 	PayoutStatusPaid      PayoutStatus = "paid"
 	PayoutStatusFailed    PayoutStatus = "failed"
 	PayoutStatusCancelled PayoutStatus = "canceled"
+
+	// PayoutStatusPending is the payout status of balance transactions not
+	// yet assigned to a payout
+	PayoutStatusPending PayoutStatus = "pending"
 )
 
 // Represents the result from the payouts/X.json endpoint
@@ -26,6 +26,9 @@ type ProductService interface {
 
 	// MetafieldsService used for Product resource to communicate with Metafields resource
 	MetafieldsService
+
+	// EventsService used for Product resource to communicate with Events resource
+	EventsService
 }
 
 // ProductServiceOp handles communication with the product related methods of
@@ -210,3 +213,15 @@ func (s *ProductServiceOp) DeleteMetafield(productID int64, metafieldID int64) e
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
 	return metafieldService.Delete(metafieldID)
 }
+
+// ListEvents for a product
+func (s *ProductServiceOp) ListEvents(productID int64, options interface{}) ([]Event, error) {
+	eventService := &EventServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
+	return eventService.List(options)
+}
+
+// ListEventsWithPagination for a product
+func (s *ProductServiceOp) ListEventsWithPagination(productID int64, options interface{}) ([]Event, *Pagination, error) {
+	eventService := &EventServiceOp{client: s.client, resource: productsResourceName, resourceID: productID}
+	return eventService.ListWithPagination(options)
+}
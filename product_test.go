@@ -439,3 +439,21 @@ func TestProductDeleteMetafield(t *testing.T) {
 		t.Errorf("Product.DeleteMetafield() returned error: %v", err)
 	}
 }
+
+func TestProductListEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1/events.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"events": [{"id":1,"verb":"create"},{"id":2,"verb":"update"}]}`))
+
+	events, err := client.Product.ListEvents(1, nil)
+	if err != nil {
+		t.Errorf("Product.ListEvents() returned error: %v", err)
+	}
+
+	expected := []Event{{ID: 1, Verb: "create"}, {ID: 2, Verb: "update"}}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Product.ListEvents() returned %+v, expected %+v", events, expected)
+	}
+}
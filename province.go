@@ -0,0 +1,82 @@
+package goshopify
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const provincesResourceName = "provinces"
+
+// ProvinceService is an interface for interfacing with the province endpoints
+// of the Shopify API. Provinces are created and deleted with their country.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/province
+type ProvinceService interface {
+	List(int64, interface{}) ([]Province, error)
+	Count(int64, interface{}) (int, error)
+	Get(int64, int64, interface{}) (*Province, error)
+	Update(int64, Province) (*Province, error)
+}
+
+// ProvinceServiceOp handles communication with the province related methods
+// of the Shopify API.
+type ProvinceServiceOp struct {
+	client *Client
+}
+
+// Province represents a province of a country, with its tax rate. Tax is a
+// rate, e.g. 0.05 for 5%, and TaxPercentage the same rate as a percentage.
+// TaxType is how the tax applies with the country tax: "normal", "harmonized"
+// or "compounded".
+type Province struct {
+	ID             int64            `json:"id,omitempty"`
+	CountryID      int64            `json:"country_id,omitempty"`
+	ShippingZoneID int64            `json:"shipping_zone_id,omitempty"`
+	Code           string           `json:"code,omitempty"`
+	Name           string           `json:"name,omitempty"`
+	Tax            *decimal.Decimal `json:"tax,omitempty"`
+	TaxName        string           `json:"tax_name,omitempty"`
+	TaxType        string           `json:"tax_type,omitempty"`
+	TaxPercentage  *decimal.Decimal `json:"tax_percentage,omitempty"`
+}
+
+// ProvinceResource represents the result from the countries/X/provinces/Y.json endpoint
+type ProvinceResource struct {
+	Province *Province `json:"province"`
+}
+
+// ProvincesResource represents the result from the countries/X/provinces.json endpoint
+type ProvincesResource struct {
+	Provinces []Province `json:"provinces"`
+}
+
+// List provinces of a country
+func (s *ProvinceServiceOp) List(countryID int64, options interface{}) ([]Province, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", countriesBasePath, countryID, provincesResourceName)
+	resource := new(ProvincesResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Provinces, err
+}
+
+// Count provinces of a country
+func (s *ProvinceServiceOp) Count(countryID int64, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/%d/%s/count.json", countriesBasePath, countryID, provincesResourceName)
+	return s.client.Count(path, options)
+}
+
+// Get individual province
+func (s *ProvinceServiceOp) Get(countryID int64, provinceID int64, options interface{}) (*Province, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", countriesBasePath, countryID, provincesResourceName, provinceID)
+	resource := new(ProvinceResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Province, err
+}
+
+// Update an existing province
+func (s *ProvinceServiceOp) Update(countryID int64, province Province) (*Province, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", countriesBasePath, countryID, provincesResourceName, province.ID)
+	wrappedData := ProvinceResource{Province: &province}
+	resource := new(ProvinceResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Province, err
+}
@@ -0,0 +1,92 @@
+package goshopify
+
+import (
+	"fmt"
+)
+
+const refundsResourceName = "refunds"
+
+// RefundService is an interface for interfacing with the refund endpoints of
+// the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/refund
+type RefundService interface {
+	List(int64, interface{}) ([]Refund, error)
+	ListWithPagination(int64, interface{}) ([]Refund, *Pagination, error)
+	Get(int64, int64, interface{}) (*Refund, error)
+	Calculate(int64, Refund) (*Refund, error)
+	Create(int64, Refund) (*Refund, error)
+}
+
+// RefundServiceOp handles communication with the refund related methods of the
+// Shopify API.
+type RefundServiceOp struct {
+	client *Client
+}
+
+// RefundResource represents the result from the orders/X/refunds/Y.json endpoint
+type RefundResource struct {
+	Refund *Refund `json:"refund"`
+}
+
+// RefundsResource represents the result from the orders/X/refunds.json endpoint
+type RefundsResource struct {
+	Refunds []Refund `json:"refunds"`
+}
+
+// A struct for all available refund list and get options.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/refund#get-orders-order-id-refunds
+type RefundListOptions struct {
+	ListOptions
+	InShopCurrency bool `url:"in_shop_currency,omitempty"`
+}
+
+// List refunds of an order
+func (s *RefundServiceOp) List(orderID int64, options interface{}) ([]Refund, error) {
+	refunds, _, err := s.ListWithPagination(orderID, options)
+	if err != nil {
+		return nil, err
+	}
+	return refunds, nil
+}
+
+// ListWithPagination lists refunds of an order and return pagination to retrieve next/previous results.
+func (s *RefundServiceOp) ListWithPagination(orderID int64, options interface{}) ([]Refund, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", ordersBasePath, orderID, refundsResourceName)
+	resource := new(RefundsResource)
+
+	pagination, err := s.client.ListWithPagination(path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Refunds, pagination, nil
+}
+
+// Get individual refund
+func (s *RefundServiceOp) Get(orderID int64, refundID int64, options interface{}) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", ordersBasePath, orderID, refundsResourceName, refundID)
+	resource := new(RefundResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Refund, err
+}
+
+// Calculate the line items, shipping and suggested transactions of a refund
+// without creating it. The transactions of the result have the kind
+// "suggested_refund" and must be changed to "refund" before being passed to
+// Create.
+func (s *RefundServiceOp) Calculate(orderID int64, refund Refund) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/%s/calculate.json", ordersBasePath, orderID, refundsResourceName)
+	wrappedData := RefundResource{Refund: &refund}
+	resource := new(RefundResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Refund, err
+}
+
+// Create a new refund
+func (s *RefundServiceOp) Create(orderID int64, refund Refund) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", ordersBasePath, orderID, refundsResourceName)
+	wrappedData := RefundResource{Refund: &refund}
+	resource := new(RefundResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Refund, err
+}
@@ -0,0 +1,196 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func refundTests(t *testing.T, refund Refund) {
+	expectedID := int64(509562969)
+	if refund.Id != expectedID {
+		t.Errorf("Refund.Id returned %+v, expected %+v", refund.Id, expectedID)
+	}
+
+	expectedOrderID := int64(450789469)
+	if refund.OrderId != expectedOrderID {
+		t.Errorf("Refund.OrderId returned %+v, expected %+v", refund.OrderId, expectedOrderID)
+	}
+
+	expectedNote := "it broke during shipping"
+	if refund.Note != expectedNote {
+		t.Errorf("Refund.Note returned %+v, expected %+v", refund.Note, expectedNote)
+	}
+
+	expectedProcessedAt := time.Date(2023, time.October, 3, 17, 19, 4, 0, time.UTC)
+	if refund.ProcessedAt == nil || !expectedProcessedAt.Equal(*refund.ProcessedAt) {
+		t.Errorf("Refund.ProcessedAt returned %+v, expected %+v", refund.ProcessedAt, expectedProcessedAt)
+	}
+
+	if len(refund.RefundLineItems) != 1 {
+		t.Fatalf("Refund.RefundLineItems returned %d items, expected 1", len(refund.RefundLineItems))
+	}
+	lineItem := refund.RefundLineItems[0]
+	if lineItem.RestockType != RefundRestockTypeReturn {
+		t.Errorf("RefundLineItem.RestockType returned %+v, expected %+v", lineItem.RestockType, RefundRestockTypeReturn)
+	}
+	expectedLocationID := int64(487838322)
+	if lineItem.LocationId == nil || *lineItem.LocationId != expectedLocationID {
+		t.Errorf("RefundLineItem.LocationId returned %+v, expected %+v", lineItem.LocationId, expectedLocationID)
+	}
+	expectedSubtotal := decimal.NewFromFloat(195.66)
+	if lineItem.Subtotal == nil || !lineItem.Subtotal.Equal(expectedSubtotal) {
+		t.Errorf("RefundLineItem.Subtotal returned %+v, expected %+v", lineItem.Subtotal, expectedSubtotal)
+	}
+
+	if len(refund.Transactions) != 1 {
+		t.Fatalf("Refund.Transactions returned %d transactions, expected 1", len(refund.Transactions))
+	}
+	expectedAmount := decimal.NewFromFloat(209)
+	if refund.Transactions[0].Kind != "refund" || !refund.Transactions[0].Amount.Equal(expectedAmount) {
+		t.Errorf("Refund.Transactions returned %+v, expected a refund of %v", refund.Transactions[0], expectedAmount)
+	}
+}
+
+func TestRefundList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refunds.json")))
+
+	refunds, err := client.Refund.List(450789469, nil)
+	if err != nil {
+		t.Errorf("Refund.List returned error: %v", err)
+	}
+
+	if len(refunds) != 1 {
+		t.Fatalf("Refund.List returned %d refunds, expected 1", len(refunds))
+	}
+	refundTests(t, refunds[0])
+}
+
+func TestRefundListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix)
+	linkHeader := `<http://valid.url?page_info=pageInfoCode&limit=1>; rel="next"`
+
+	httpmock.RegisterResponder("GET", listURL, httpmock.ResponderFromResponse(&http.Response{
+		StatusCode: 200,
+		Body:       httpmock.NewRespBodyFromBytes(loadFixture("refunds.json")),
+		Header:     http.Header{"Link": {linkHeader}},
+	}))
+
+	refunds, pagination, err := client.Refund.ListWithPagination(450789469, RefundListOptions{ListOptions: ListOptions{Limit: 1}})
+	if err != nil {
+		t.Fatalf("Refund.ListWithPagination returned error: %v", err)
+	}
+	if len(refunds) != 1 {
+		t.Errorf("Refund.ListWithPagination returned %d refunds, expected 1", len(refunds))
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pageInfoCode", Limit: 1}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Refund.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestRefundGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds/509562969.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refund.json")))
+
+	refund, err := client.Refund.Get(450789469, 509562969, nil)
+	if err != nil {
+		t.Fatalf("Refund.Get returned error: %v", err)
+	}
+
+	refundTests(t, *refund)
+
+	if len(refund.OrderAdjustments) != 1 || refund.OrderAdjustments[0].Kind != OrderAdjustmentTypeShippingRefund {
+		t.Errorf("Refund.OrderAdjustments returned %+v, expected a shipping refund", refund.OrderAdjustments)
+	}
+}
+
+func TestRefundCalculate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds/calculate.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refund_calculate.json")))
+
+	refund, err := client.Refund.Calculate(450789469, Refund{
+		Shipping: &RefundShipping{FullRefund: true},
+		RefundLineItems: []RefundLineItem{
+			{LineItemId: 518995019, Quantity: 1, RestockType: RefundRestockTypeNoRestock},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Refund.Calculate returned error: %v", err)
+	}
+
+	expectedShipping := decimal.NewFromFloat(5)
+	if refund.Shipping == nil || !refund.Shipping.MaximumRefundable.Equal(expectedShipping) {
+		t.Errorf("Refund.Shipping returned %+v, expected a maximum refundable of %v", refund.Shipping, expectedShipping)
+	}
+
+	if len(refund.Transactions) != 1 {
+		t.Fatalf("Refund.Transactions returned %d transactions, expected 1", len(refund.Transactions))
+	}
+	suggested := refund.Transactions[0]
+	expectedMaximum := decimal.NewFromFloat(41.94)
+	if suggested.Kind != "suggested_refund" || !suggested.MaximumRefundable.Equal(expectedMaximum) {
+		t.Errorf("Refund.Transactions returned %+v, expected a suggested refund with maximum refundable %v", suggested, expectedMaximum)
+	}
+}
+
+func TestRefundCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requested RefundResource
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&requested); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("refund.json")), nil
+		})
+
+	locationID := int64(487838322)
+	amount := decimal.NewFromFloat(209)
+	parentID := int64(801038806)
+	refund, err := client.Refund.Create(450789469, Refund{
+		Currency: "USD",
+		Notify:   true,
+		Note:     "it broke during shipping",
+		Shipping: &RefundShipping{Amount: &amount},
+		RefundLineItems: []RefundLineItem{
+			{LineItemId: 703073504, Quantity: 1, RestockType: RefundRestockTypeReturn, LocationId: &locationID},
+		},
+		Transactions: []Transaction{
+			{ParentID: &parentID, Amount: &amount, Kind: "refund", Gateway: "bogus"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Refund.Create returned error: %v", err)
+	}
+
+	refundTests(t, *refund)
+
+	sent := requested.Refund
+	if sent == nil || !sent.Notify || len(sent.RefundLineItems) != 1 || sent.RefundLineItems[0].RestockType != RefundRestockTypeReturn ||
+		*sent.RefundLineItems[0].LocationId != locationID {
+		t.Errorf("Refund.Create sent %+v, expected the refund line items with restock type and location", sent)
+	}
+}
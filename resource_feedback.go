@@ -0,0 +1,94 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+const resourceFeedbackBasePath = "resource_feedback"
+
+// ResourceFeedbackService is an interface for interfacing with the resource
+// feedback endpoints of the Shopify API. Sales channels use it to tell the
+// merchant about setup problems with the shop or its products.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/resourcefeedback
+type ResourceFeedbackService interface {
+	List() ([]ResourceFeedback, error)
+	Create(ResourceFeedback) (*ResourceFeedback, error)
+	ListProductFeedback(int64) ([]ResourceFeedback, error)
+	CreateProductFeedback(int64, ResourceFeedback) (*ResourceFeedback, error)
+}
+
+// ResourceFeedbackServiceOp handles communication with the resource feedback
+// related methods of the Shopify API.
+type ResourceFeedbackServiceOp struct {
+	client *Client
+}
+
+type ResourceFeedbackState string
+
+const (
+	ResourceFeedbackStateSuccess        ResourceFeedbackState = "success"
+	ResourceFeedbackStateRequiresAction ResourceFeedbackState = "requires_action"
+)
+
+// ResourceFeedback represents the feedback of a sales channel on the shop or a
+// product. Messages explain the problem when the state is requires_action and
+// must be empty on success. FeedbackGeneratedAt orders feedback, older
+// feedback is ignored. ResourceUpdatedAt is the updated_at of the product the
+// feedback was generated for.
+type ResourceFeedback struct {
+	ResourceID          int64                 `json:"resource_id,omitempty"`
+	ResourceType        string                `json:"resource_type,omitempty"`
+	State               ResourceFeedbackState `json:"state,omitempty"`
+	Messages            []string              `json:"messages"`
+	FeedbackGeneratedAt *time.Time            `json:"feedback_generated_at,omitempty"`
+	ResourceUpdatedAt   *time.Time            `json:"resource_updated_at,omitempty"`
+	CreatedAt           *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time            `json:"updated_at,omitempty"`
+}
+
+// ResourceFeedbackResource represents the result from creating resource feedback
+type ResourceFeedbackResource struct {
+	ResourceFeedback *ResourceFeedback `json:"resource_feedback"`
+}
+
+// ResourceFeedbacksResource represents the result from the resource_feedback.json endpoint
+type ResourceFeedbacksResource struct {
+	ResourceFeedback []ResourceFeedback `json:"resource_feedback"`
+}
+
+// List the feedback on the shop
+func (s *ResourceFeedbackServiceOp) List() ([]ResourceFeedback, error) {
+	return s.list(fmt.Sprintf("%s.json", resourceFeedbackBasePath))
+}
+
+// Create feedback on the shop
+func (s *ResourceFeedbackServiceOp) Create(feedback ResourceFeedback) (*ResourceFeedback, error) {
+	return s.create(fmt.Sprintf("%s.json", resourceFeedbackBasePath), feedback)
+}
+
+// ListProductFeedback lists the feedback on a product
+func (s *ResourceFeedbackServiceOp) ListProductFeedback(productID int64) ([]ResourceFeedback, error) {
+	return s.list(fmt.Sprintf("%s/%d/%s.json", productsBasePath, productID, resourceFeedbackBasePath))
+}
+
+// CreateProductFeedback creates feedback on a product
+func (s *ResourceFeedbackServiceOp) CreateProductFeedback(productID int64, feedback ResourceFeedback) (*ResourceFeedback, error) {
+	return s.create(fmt.Sprintf("%s/%d/%s.json", productsBasePath, productID, resourceFeedbackBasePath), feedback)
+}
+
+func (s *ResourceFeedbackServiceOp) list(path string) ([]ResourceFeedback, error) {
+	resource := new(ResourceFeedbacksResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.ResourceFeedback, err
+}
+
+func (s *ResourceFeedbackServiceOp) create(path string, feedback ResourceFeedback) (*ResourceFeedback, error) {
+	if feedback.Messages == nil {
+		feedback.Messages = []string{}
+	}
+	wrappedData := ResourceFeedbackResource{ResourceFeedback: &feedback}
+	resource := new(ResourceFeedbackResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.ResourceFeedback, err
+}
@@ -0,0 +1,133 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestResourceFeedbackList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/resource_feedback.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"resource_feedback": [{"resource_id":548380009,"resource_type":"Shop","state":"success","messages":[]}]}`))
+
+	feedback, err := client.ResourceFeedback.List()
+	if err != nil {
+		t.Errorf("ResourceFeedback.List returned error: %v", err)
+	}
+
+	expected := []ResourceFeedback{{ResourceID: 548380009, ResourceType: "Shop", State: ResourceFeedbackStateSuccess, Messages: []string{}}}
+	if !reflect.DeepEqual(feedback, expected) {
+		t.Errorf("ResourceFeedback.List returned %+v, expected %+v", feedback, expected)
+	}
+}
+
+func TestResourceFeedbackCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/resource_feedback.json", client.pathPrefix),
+		httpmock.NewBytesResponder(202, loadFixture("resource_feedback.json")))
+
+	generatedAt := time.Date(2023, time.October, 3, 17, 24, 32, 0, time.UTC)
+	feedback, err := client.ResourceFeedback.Create(ResourceFeedback{
+		State:               ResourceFeedbackStateRequiresAction,
+		Messages:            []string{"is not connected. Connect your account to use this sales channel."},
+		FeedbackGeneratedAt: &generatedAt,
+	})
+	if err != nil {
+		t.Fatalf("ResourceFeedback.Create returned error: %v", err)
+	}
+
+	if feedback.ResourceType != "Shop" || feedback.State != ResourceFeedbackStateRequiresAction || len(feedback.Messages) != 1 {
+		t.Errorf("ResourceFeedback.Create returned %+v, expected shop feedback requiring action", feedback)
+	}
+
+	if feedback.FeedbackGeneratedAt == nil || !generatedAt.Equal(*feedback.FeedbackGeneratedAt) {
+		t.Errorf("ResourceFeedback.FeedbackGeneratedAt returned %+v, expected %+v", feedback.FeedbackGeneratedAt, generatedAt)
+	}
+}
+
+func TestResourceFeedbackCreateSuccess(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/resource_feedback.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]map[string]interface{}{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			messages, ok := body["resource_feedback"]["messages"].([]interface{})
+			if !ok || len(messages) != 0 {
+				t.Errorf("ResourceFeedback.Create sent messages %+v, expected an empty list", body["resource_feedback"]["messages"])
+			}
+			return httpmock.NewStringResponse(202, `{"resource_feedback": {"state":"success","messages":[]}}`), nil
+		})
+
+	_, err := client.ResourceFeedback.Create(ResourceFeedback{State: ResourceFeedbackStateSuccess})
+	if err != nil {
+		t.Errorf("ResourceFeedback.Create returned error: %v", err)
+	}
+}
+
+func TestResourceFeedbackListProductFeedback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/632910392/resource_feedback.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("product_resource_feedback.json")))
+
+	feedback, err := client.ResourceFeedback.ListProductFeedback(632910392)
+	if err != nil {
+		t.Fatalf("ResourceFeedback.ListProductFeedback returned error: %v", err)
+	}
+	if len(feedback) != 1 {
+		t.Fatalf("ResourceFeedback.ListProductFeedback returned %d feedback, expected 1", len(feedback))
+	}
+
+	expectedUpdatedAt := time.Date(2023, time.October, 3, 17, 14, 49, 0, time.UTC)
+	if feedback[0].ResourceUpdatedAt == nil || !expectedUpdatedAt.Equal(*feedback[0].ResourceUpdatedAt) {
+		t.Errorf("ResourceFeedback.ResourceUpdatedAt returned %+v, expected %+v", feedback[0].ResourceUpdatedAt, expectedUpdatedAt)
+	}
+
+	expectedMessages := []string{"Needs at least one image."}
+	if !reflect.DeepEqual(feedback[0].Messages, expectedMessages) {
+		t.Errorf("ResourceFeedback.Messages returned %+v, expected %+v", feedback[0].Messages, expectedMessages)
+	}
+}
+
+func TestResourceFeedbackCreateProductFeedback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/632910392/resource_feedback.json", client.pathPrefix),
+		httpmock.NewStringResponder(202, `{"resource_feedback": {"resource_id":632910392,"resource_type":"Product","state":"requires_action","messages":["Needs at least one image."]}}`))
+
+	updatedAt := time.Date(2023, time.October, 3, 17, 14, 49, 0, time.UTC)
+	feedback, err := client.ResourceFeedback.CreateProductFeedback(632910392, ResourceFeedback{
+		State:             ResourceFeedbackStateRequiresAction,
+		Messages:          []string{"Needs at least one image."},
+		ResourceUpdatedAt: &updatedAt,
+	})
+	if err != nil {
+		t.Fatalf("ResourceFeedback.CreateProductFeedback returned error: %v", err)
+	}
+
+	expected := &ResourceFeedback{
+		ResourceID:   632910392,
+		ResourceType: "Product",
+		State:        ResourceFeedbackStateRequiresAction,
+		Messages:     []string{"Needs at least one image."},
+	}
+	if !reflect.DeepEqual(feedback, expected) {
+		t.Errorf("ResourceFeedback.CreateProductFeedback returned %+v, expected %+v", feedback, expected)
+	}
+}
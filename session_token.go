@@ -0,0 +1,164 @@
+package goshopify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sessionTokenClockSkew is the leeway allowed when checking the exp and nbf
+// claims of a session token, to account for clock differences with Shopify.
+const sessionTokenClockSkew = 10 * time.Second
+
+// sessionTokenNow returns the current time, overridden in tests.
+var sessionTokenNow = time.Now
+
+type sessionTokenContextKey struct{}
+
+// SessionTokenClaims represents the payload of a session token issued by
+// App Bridge to embedded apps.
+// See https://shopify.dev/docs/apps/auth/oauth/session-tokens
+type SessionTokenClaims struct {
+	Issuer      string `json:"iss"`
+	Destination string `json:"dest"`
+	Audience    string `json:"aud"`
+	Subject     string `json:"sub"`
+	ExpiresAt   int64  `json:"exp"`
+	NotBefore   int64  `json:"nbf"`
+	IssuedAt    int64  `json:"iat"`
+	ID          string `json:"jti"`
+	SessionID   string `json:"sid"`
+}
+
+// Shop returns the myshopify domain of the shop the token was issued for,
+// taken from the dest claim.
+func (c SessionTokenClaims) Shop() string {
+	u, err := url.Parse(c.Destination)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// UserID returns the ID of the staff member the token was issued for, taken
+// from the sub claim, or 0 if it is not set.
+func (c SessionTokenClaims) UserID() int64 {
+	id, _ := strconv.ParseInt(c.Subject, 10, 64)
+	return id
+}
+
+// VerifySessionToken verifies a session token sent by App Bridge and returns
+// its claims. The token must be signed with the app secret using HS256, be
+// within its validity period, have the app's api key as audience and have
+// issuer and destination claims for the same myshopify domain, see
+// ValidateShopDomain.
+func (app App) VerifySessionToken(token string) (*SessionTokenClaims, error) {
+	if app.ApiSecret == "" {
+		return nil, errors.New("ApiSecret is empty")
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("session token is malformed")
+	}
+
+	header := struct {
+		Alg string `json:"alg"`
+		Typ string `json:"typ"`
+	}{}
+	if err := decodeSessionTokenSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("session token header: %v", err)
+	}
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("session token algorithm %s is not supported", header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("session token signature: %v", err)
+	}
+	mac := hmac.New(sha256.New, []byte(app.ApiSecret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errors.New("session token signature is invalid")
+	}
+
+	claims := new(SessionTokenClaims)
+	if err := decodeSessionTokenSegment(parts[1], claims); err != nil {
+		return nil, fmt.Errorf("session token payload: %v", err)
+	}
+
+	now := sessionTokenNow()
+	if now.After(time.Unix(claims.ExpiresAt, 0).Add(sessionTokenClockSkew)) {
+		return nil, errors.New("session token has expired")
+	}
+	if now.Before(time.Unix(claims.NotBefore, 0).Add(-sessionTokenClockSkew)) {
+		return nil, errors.New("session token is not valid yet")
+	}
+
+	if claims.Audience != app.ApiKey {
+		return nil, fmt.Errorf("session token audience %s does not match api key", claims.Audience)
+	}
+
+	dest, err := url.Parse(claims.Destination)
+	if err != nil || dest.Host == "" {
+		return nil, fmt.Errorf("session token destination %s is invalid", claims.Destination)
+	}
+	if err := ValidateShopDomain(dest.Host); err != nil {
+		return nil, fmt.Errorf("session token destination %s is not a shop: %v", claims.Destination, err)
+	}
+	iss, err := url.Parse(claims.Issuer)
+	if err != nil || iss.Host != dest.Host {
+		return nil, fmt.Errorf("session token issuer %s does not match destination %s", claims.Issuer, claims.Destination)
+	}
+
+	return claims, nil
+}
+
+func decodeSessionTokenSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// SessionTokenMiddleware returns an http middleware that verifies the session
+// token sent as a bearer token in the Authorization header. Requests with a
+// missing or invalid token are rejected with 401 Unauthorized, otherwise the
+// verified claims are stored in the request context, see
+// SessionTokenFromContext.
+func (app App) SessionTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "Bearer ") {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		claims, err := app.VerifySessionToken(strings.TrimPrefix(auth, "Bearer "))
+		if err != nil {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), sessionTokenContextKey{}, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// SessionTokenFromContext returns the session token claims stored in the
+// context by SessionTokenMiddleware.
+func SessionTokenFromContext(ctx context.Context) (*SessionTokenClaims, bool) {
+	claims, ok := ctx.Value(sessionTokenContextKey{}).(*SessionTokenClaims)
+	return claims, ok
+}
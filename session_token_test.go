@@ -0,0 +1,165 @@
+package goshopify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var sessionTokenTestTime = time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func signSessionToken(secret, alg string, claims interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func validSessionTokenClaims() SessionTokenClaims {
+	return SessionTokenClaims{
+		Issuer:      "https://fooshop.myshopify.com/admin",
+		Destination: "https://fooshop.myshopify.com",
+		Audience:    "apikey",
+		Subject:     "42",
+		ExpiresAt:   sessionTokenTestTime.Add(time.Minute).Unix(),
+		NotBefore:   sessionTokenTestTime.Add(-time.Minute).Unix(),
+		IssuedAt:    sessionTokenTestTime.Add(-time.Minute).Unix(),
+		ID:          "00000000-0000-0000-0000-000000000000",
+		SessionID:   "abcdef",
+	}
+}
+
+func setSessionTokenNow(t time.Time) func() {
+	sessionTokenNow = func() time.Time { return t }
+	return func() { sessionTokenNow = time.Now }
+}
+
+func TestVerifySessionToken(t *testing.T) {
+	setup()
+	defer teardown()
+	defer setSessionTokenNow(sessionTokenTestTime)()
+
+	expected := validSessionTokenClaims()
+	claims, err := app.VerifySessionToken(signSessionToken("hush", "HS256", expected))
+	if err != nil {
+		t.Fatalf("App.VerifySessionToken returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(*claims, expected) {
+		t.Errorf("App.VerifySessionToken returned %+v, expected %+v", *claims, expected)
+	}
+	if claims.Shop() != "fooshop.myshopify.com" {
+		t.Errorf("SessionTokenClaims.Shop() = %s, expected fooshop.myshopify.com", claims.Shop())
+	}
+	if claims.UserID() != 42 {
+		t.Errorf("SessionTokenClaims.UserID() = %d, expected 42", claims.UserID())
+	}
+}
+
+func TestVerifySessionTokenClockSkew(t *testing.T) {
+	setup()
+	defer teardown()
+
+	claims := validSessionTokenClaims()
+	token := signSessionToken("hush", "HS256", claims)
+
+	cases := []struct {
+		now     time.Time
+		wantErr bool
+	}{
+		{time.Unix(claims.ExpiresAt, 0).Add(sessionTokenClockSkew / 2), false},
+		{time.Unix(claims.ExpiresAt, 0).Add(sessionTokenClockSkew * 2), true},
+		{time.Unix(claims.NotBefore, 0).Add(-sessionTokenClockSkew / 2), false},
+		{time.Unix(claims.NotBefore, 0).Add(-sessionTokenClockSkew * 2), true},
+	}
+
+	for _, c := range cases {
+		reset := setSessionTokenNow(c.now)
+		_, err := app.VerifySessionToken(token)
+		reset()
+		if (err != nil) != c.wantErr {
+			t.Errorf("App.VerifySessionToken at %s returned error %v, expected error %v", c.now, err, c.wantErr)
+		}
+	}
+}
+
+func TestVerifySessionTokenInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+	defer setSessionTokenNow(sessionTokenTestTime)()
+
+	wrongAudience := validSessionTokenClaims()
+	wrongAudience.Audience = "otherkey"
+
+	shopMismatch := validSessionTokenClaims()
+	shopMismatch.Issuer = "https://evilshop.myshopify.com/admin"
+
+	notAShop := validSessionTokenClaims()
+	notAShop.Issuer = "https://evil.com/admin"
+	notAShop.Destination = "https://evil.com"
+
+	cases := []struct {
+		name  string
+		token string
+	}{
+		{"malformed", "not.a-token"},
+		{"wrong secret", signSessionToken("wrong", "HS256", validSessionTokenClaims())},
+		{"wrong algorithm", signSessionToken("hush", "none", validSessionTokenClaims())},
+		{"wrong audience", signSessionToken("hush", "HS256", wrongAudience)},
+		{"issuer and destination mismatch", signSessionToken("hush", "HS256", shopMismatch)},
+		{"destination not a shop", signSessionToken("hush", "HS256", notAShop)},
+	}
+
+	for _, c := range cases {
+		if _, err := app.VerifySessionToken(c.token); err == nil {
+			t.Errorf("App.VerifySessionToken with %s token returned no error", c.name)
+		}
+	}
+}
+
+func TestSessionTokenMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+	defer setSessionTokenNow(sessionTokenTestTime)()
+
+	var claims *SessionTokenClaims
+	handler := app.SessionTokenMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, _ = SessionTokenFromContext(r.Context())
+	}))
+
+	cases := []struct {
+		auth           string
+		expectedStatus int
+		expectedShop   string
+	}{
+		{"Bearer " + signSessionToken("hush", "HS256", validSessionTokenClaims()), http.StatusOK, "fooshop.myshopify.com"},
+		{"Bearer " + signSessionToken("wrong", "HS256", validSessionTokenClaims()), http.StatusUnauthorized, ""},
+		{"", http.StatusUnauthorized, ""},
+	}
+
+	for _, c := range cases {
+		claims = nil
+		req := httptest.NewRequest("GET", "/api/products", nil)
+		if c.auth != "" {
+			req.Header.Set("Authorization", c.auth)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != c.expectedStatus {
+			t.Errorf("SessionTokenMiddleware returned status %d, expected %d", rec.Code, c.expectedStatus)
+		}
+		if c.expectedShop != "" && (claims == nil || claims.Shop() != c.expectedShop) {
+			t.Errorf("SessionTokenFromContext returned %+v, expected shop %s", claims, c.expectedShop)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package shopifytest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FaultKind is a kind of failure injected by a FaultTransport
+type FaultKind int
+
+const (
+	// FaultRateLimit responds 429 Too Many Requests with a Retry-After header
+	FaultRateLimit FaultKind = iota
+
+	// FaultServiceUnavailable responds 503 Service Unavailable
+	FaultServiceUnavailable
+
+	// FaultTruncatedBody sends the request and cuts the response body in half
+	FaultTruncatedBody
+
+	// FaultDelay waits for Delay before sending the request, or until the
+	// request is canceled
+	FaultDelay
+
+	// FaultGraphQLThrottled responds with a GraphQL THROTTLED error whose
+	// cost extension asks to retry after RetryAfter seconds
+	FaultGraphQLThrottled
+)
+
+// Fault describes a failure injected by a FaultTransport
+type Fault struct {
+	Kind FaultKind
+
+	// RetryAfter is the wait in seconds requested by FaultRateLimit and
+	// FaultGraphQLThrottled faults
+	RetryAfter float64
+
+	// Delay is the wait of FaultDelay faults
+	Delay time.Duration
+}
+
+// FaultTransport is an http.RoundTripper injecting failures in requests sent
+// to Shopify, to test retry, throttling and error handling. Faults are either
+// scripted per path with Script, or injected at random with Random. Use it
+// with goshopify.WithHTTPClient:
+//
+//	faults := shopifytest.NewFaultTransport(srv.Client().Transport)
+//	faults.Script("products.json", shopifytest.Fault{Kind: shopifytest.FaultRateLimit})
+//	client := goshopify.NewClient(app, "fooshop", token, goshopify.WithHTTPClient(faults.Client()))
+type FaultTransport struct {
+	// Transport sends requests that are not failed, defaults to
+	// http.DefaultTransport
+	Transport http.RoundTripper
+
+	mu          sync.Mutex
+	scripted    map[string][]Fault
+	probability float64
+	random      []Fault
+	rand        *rand.Rand
+	injected    int
+}
+
+// NewFaultTransport returns a FaultTransport sending requests with transport,
+// which may be nil to use http.DefaultTransport.
+func NewFaultTransport(transport http.RoundTripper) *FaultTransport {
+	return &FaultTransport{
+		Transport: transport,
+		scripted:  map[string][]Fault{},
+	}
+}
+
+// Client returns an http client using the fault transport.
+func (t *FaultTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// Script queues faults for the next requests whose path ends with path,
+// e.g. "products.json" or "graphql.json". Each fault is injected once, in
+// order. When several scripted paths match a request, the longest one is
+// used.
+func (t *FaultTransport) Script(path string, faults ...Fault) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.scripted[path] = append(t.scripted[path], faults...)
+}
+
+// Random injects one of faults, picked at random, in requests with the given
+// probability between 0 and 1. The seed makes the sequence of faults
+// reproducible.
+func (t *FaultTransport) Random(probability float64, seed int64, faults ...Fault) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.probability = probability
+	t.random = faults
+	t.rand = rand.New(rand.NewSource(seed))
+}
+
+// Injected returns the number of faults injected so far.
+func (t *FaultTransport) Injected() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.injected
+}
+
+// nextFault returns the fault to inject in the request, if any
+func (t *FaultTransport) nextFault(req *http.Request) (Fault, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// pick the longest matching path so that overlapping scripts, e.g.
+	// "count.json" and "products/count.json", are used deterministically
+	match, found := "", false
+	for path, faults := range t.scripted {
+		if len(faults) > 0 && strings.HasSuffix(req.URL.Path, path) && (!found || len(path) > len(match)) {
+			match, found = path, true
+		}
+	}
+	if found {
+		faults := t.scripted[match]
+		t.scripted[match] = faults[1:]
+		t.injected++
+		return faults[0], true
+	}
+
+	if len(t.random) > 0 && t.rand.Float64() < t.probability {
+		t.injected++
+		return t.random[t.rand.Intn(len(t.random))], true
+	}
+
+	return Fault{}, false
+}
+
+// RoundTrip sends the request, injecting the next fault if any.
+func (t *FaultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	fault, ok := t.nextFault(req)
+	if !ok {
+		return transport.RoundTrip(req)
+	}
+
+	switch fault.Kind {
+	case FaultRateLimit:
+		resp := faultResponse(req, http.StatusTooManyRequests, `{"errors":"Exceeded 2 calls per second for api client. Reduce request rates to resume uninterrupted service."}`)
+		resp.Header.Set("Retry-After", strconv.FormatFloat(fault.RetryAfter, 'f', 1, 64))
+		return resp, nil
+	case FaultServiceUnavailable:
+		return faultResponse(req, http.StatusServiceUnavailable, `{"errors":"Service Unavailable"}`), nil
+	case FaultGraphQLThrottled:
+		// the cost extension is set so that GraphQLCost.RetryAfterSeconds
+		// returns fault.RetryAfter
+		const requested, restoreRate = 10, 50.0
+		available := requested - fault.RetryAfter*restoreRate
+		body := fmt.Sprintf(`{"errors":[{"message":"Throttled","extensions":{"code":"THROTTLED","documentation":"https://shopify.dev/api/usage/rate-limits"}}],`+
+			`"extensions":{"cost":{"requestedQueryCost":%d,"actualQueryCost":null,"throttleStatus":{"maximumAvailable":1000,"currentlyAvailable":%g,"restoreRate":%g}}}}`,
+			requested, available, restoreRate)
+		return faultResponse(req, http.StatusOK, body), nil
+	case FaultDelay:
+		timer := time.NewTimer(fault.Delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		return transport.RoundTrip(req)
+	case FaultTruncatedBody:
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = body[:len(body)/2]
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Del("Content-Length")
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("unknown fault kind %d", fault.Kind)
+}
+
+func faultResponse(req *http.Request, status int, body string) *http.Response {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,163 @@
+package shopifytest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v3"
+)
+
+func newFaultClient(t *FaultTransport, opts ...goshopify.Option) *goshopify.Client {
+	opts = append([]goshopify.Option{
+		goshopify.WithVersion("2023-01"),
+		goshopify.WithHTTPClient(t.Client()),
+	}, opts...)
+	return goshopify.NewClient(goshopify.App{}, "fooshop", "token", opts...)
+}
+
+func TestFaultTransportRetries(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	faults := NewFaultTransport(srv.Client().Transport)
+	faults.Script("products/count.json",
+		Fault{Kind: FaultRateLimit},
+		Fault{Kind: FaultServiceUnavailable},
+	)
+
+	client := newFaultClient(faults, goshopify.WithRetry(3))
+	if _, err := client.Product.Count(nil); err != nil {
+		t.Errorf("Product.Count with retries returned error: %v", err)
+	}
+	if faults.Injected() != 2 {
+		t.Errorf("FaultTransport.Injected() = %d, expected 2", faults.Injected())
+	}
+
+	faults.Script("products/count.json", Fault{Kind: FaultRateLimit, RetryAfter: 2})
+	client = newFaultClient(faults)
+	_, err := client.Product.Count(nil)
+	if rateLimitErr, ok := err.(goshopify.RateLimitError); !ok || rateLimitErr.RetryAfter != 2 {
+		t.Errorf("Product.Count without retries returned error %#v, expected a RateLimitError", err)
+	}
+
+	faults.Script("products/count.json", Fault{Kind: FaultServiceUnavailable})
+	_, err = client.Product.Count(nil)
+	if responseErr, ok := err.(goshopify.ResponseError); !ok || responseErr.Status != http.StatusServiceUnavailable {
+		t.Errorf("Product.Count without retries returned error %#v, expected a 503 ResponseError", err)
+	}
+}
+
+func TestFaultTransportTruncatedBody(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.AddProduct(goshopify.Product{Title: "Snowboard"})
+
+	faults := NewFaultTransport(srv.Client().Transport)
+	faults.Script("products.json", Fault{Kind: FaultTruncatedBody})
+
+	client := newFaultClient(faults)
+	if _, err := client.Product.List(nil); err == nil {
+		t.Errorf("Product.List with a truncated body returned no error")
+	}
+	if _, err := client.Product.List(nil); err != nil {
+		t.Errorf("Product.List after the fault returned error: %v", err)
+	}
+}
+
+func TestFaultTransportDelay(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	faults := NewFaultTransport(srv.Client().Transport)
+	faults.Script("products/count.json", Fault{Kind: FaultDelay, Delay: time.Second})
+
+	client := newFaultClient(faults, goshopify.WithHTTPClient(&http.Client{
+		Transport: faults,
+		Timeout:   50 * time.Millisecond,
+	}))
+	start := time.Now()
+	if _, err := client.Product.Count(nil); err == nil {
+		t.Errorf("Product.Count with a slow response returned no error")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Product.Count took %s, expected the client timeout to cancel the delay", elapsed)
+	}
+}
+
+// graphQLTransport answers every request with the same GraphQL response
+type graphQLTransport string
+
+func (t graphQLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return faultResponse(req, http.StatusOK, string(t)), nil
+}
+
+func TestFaultTransportGraphQLThrottled(t *testing.T) {
+	faults := NewFaultTransport(graphQLTransport(`{"data":{"shop":{"name":"fooshop"}}}`))
+	faults.Script("graphql.json", Fault{Kind: FaultGraphQLThrottled})
+
+	resp := struct {
+		Shop struct {
+			Name string `json:"name"`
+		} `json:"shop"`
+	}{}
+
+	client := newFaultClient(faults, goshopify.WithRetry(2))
+	if err := client.GraphQL.Query("{ shop { name } }", nil, &resp); err != nil {
+		t.Fatalf("GraphQL.Query with retries returned error: %v", err)
+	}
+	if resp.Shop.Name != "fooshop" {
+		t.Errorf("GraphQL.Query returned shop %q, expected fooshop", resp.Shop.Name)
+	}
+
+	faults.Script("graphql.json", Fault{Kind: FaultGraphQLThrottled, RetryAfter: 3})
+	client = newFaultClient(faults)
+	err := client.GraphQL.Query("{ shop { name } }", nil, &resp)
+	if rateLimitErr, ok := err.(goshopify.RateLimitError); !ok || rateLimitErr.RetryAfter != 3 {
+		t.Errorf("GraphQL.Query without retries returned error %#v, expected a RateLimitError", err)
+	}
+}
+
+func TestFaultTransportRandom(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	faults := NewFaultTransport(srv.Client().Transport)
+	faults.Random(0.5, 42, Fault{Kind: FaultServiceUnavailable})
+
+	client := newFaultClient(faults)
+	failures := 0
+	for i := 0; i < 20; i++ {
+		if _, err := client.Product.Count(nil); err != nil {
+			failures++
+		}
+	}
+
+	if failures != faults.Injected() || failures == 0 || failures == 20 {
+		t.Errorf("got %d failures and %d injected faults out of 20 requests", failures, faults.Injected())
+	}
+}
+
+func TestFaultTransportOverlappingScripts(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	faults := NewFaultTransport(srv.Client().Transport)
+	faults.Script("count.json", Fault{Kind: FaultRateLimit})
+	faults.Script("products/count.json", Fault{Kind: FaultServiceUnavailable})
+
+	client := newFaultClient(faults)
+	_, err := client.Product.Count(nil)
+	if responseErr, ok := err.(goshopify.ResponseError); !ok || responseErr.Status != http.StatusServiceUnavailable {
+		t.Errorf("Product.Count returned error %#v, expected the 503 scripted for products/count.json", err)
+	}
+
+	_, err = client.Product.Count(nil)
+	if _, ok := err.(goshopify.RateLimitError); !ok {
+		t.Errorf("Product.Count returned error %#v, expected the 429 scripted for count.json", err)
+	}
+
+	if _, err := client.Product.Count(nil); err != nil {
+		t.Errorf("Product.Count with no scripted faults left returned error: %v", err)
+	}
+}
@@ -0,0 +1,291 @@
+package shopifytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Mode selects whether a Recorder records or replays interactions
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette and fails requests that
+	// were not recorded
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to Shopify and records the interactions, see
+	// Recorder.Save
+	ModeRecord
+)
+
+// scrubbed replaces secrets in recorded interactions
+const scrubbed = "[FILTERED]"
+
+// Headers and query or body parameters holding secrets, removed from
+// cassettes and ignored when matching requests
+var (
+	secretHeaders = []string{
+		"X-Shopify-Access-Token",
+		"Authorization",
+		"X-Shopify-Hmac-Sha256",
+		"Set-Cookie",
+		"Cookie",
+	}
+	secretParams = map[string]bool{
+		"access_token":  true,
+		"client_secret": true,
+		"hmac":          true,
+		"signature":     true,
+		"subject_token": true,
+	}
+)
+
+// Cassette is the list of interactions recorded by a Recorder
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a scrubbed request
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a scrubbed response
+type RecordedResponse struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording interactions with Shopify to a
+// cassette file, or replaying them from it. Use it with
+// goshopify.WithHTTPClient:
+//
+//	rec, err := shopifytest.NewRecorder("testdata/products.json", shopifytest.ModeReplay)
+//	client := goshopify.NewClient(app, "fooshop", token, goshopify.WithHTTPClient(rec.Client()))
+//
+// Access tokens, HMACs and other secrets are scrubbed from recorded
+// interactions. Requests are matched on method, path, query and JSON body,
+// ignoring the order of query parameters and JSON object keys, and each
+// recorded interaction is replayed once, in order.
+type Recorder struct {
+	// Transport sends requests in ModeRecord, defaults to
+	// http.DefaultTransport
+	Transport http.RoundTripper
+
+	mode     Mode
+	path     string
+	mu       sync.Mutex
+	cassette Cassette
+	replayed []bool
+}
+
+// NewRecorder returns a Recorder for the cassette file at path. In
+// ModeReplay the cassette is loaded from the file, in ModeRecord it is written
+// by Save.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path}
+
+	if mode == ModeReplay {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("cassette %s: %v", path, err)
+		}
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// Client returns an http client using the recorder as transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, b, 0644)
+}
+
+// RoundTrip records or replays a request depending on the recorder's mode.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			Status:  resp.StatusCode,
+			Headers: scrubHeaders(resp.Header),
+			Body:    scrubBody(body),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		r.replayed[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Headers.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewBufferString(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction for %s %s?%s in %s", recorded.Method, recorded.Path, recorded.Query, r.path)
+}
+
+func matches(a, b RecordedRequest) bool {
+	return a.Method == b.Method && a.Path == b.Path && a.Query == b.Query && a.Body == b.Body
+}
+
+// recordRequest returns the scrubbed, normalized form of the request, leaving
+// its body readable
+func recordRequest(req *http.Request) (RecordedRequest, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return RecordedRequest{}, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	return RecordedRequest{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Query:   scrubQuery(req.URL.Query()),
+		Headers: scrubHeaders(req.Header),
+		Body:    scrubBody(body),
+	}, nil
+}
+
+func scrubHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range secretHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, scrubbed)
+		}
+	}
+	return h
+}
+
+// scrubQuery returns the query with secrets scrubbed, encoded with sorted keys
+func scrubQuery(q url.Values) string {
+	for k := range q {
+		if secretParams[k] {
+			q.Set(k, scrubbed)
+		}
+	}
+	return q.Encode()
+}
+
+// scrubBody scrubs secrets from a JSON body and normalizes it so that the
+// order of object keys does not matter. Non JSON bodies are returned as is.
+func scrubBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return string(body)
+	}
+
+	b, err := json.Marshal(scrubValue(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(b)
+}
+
+func scrubValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, item := range t {
+			if secretParams[k] {
+				t[k] = scrubbed
+			} else {
+				t[k] = scrubValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range t {
+			t[i] = scrubValue(item)
+		}
+	}
+	return v
+}
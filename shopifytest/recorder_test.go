@@ -0,0 +1,102 @@
+package shopifytest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v3"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "products.json")
+
+	srv := NewServer()
+	rec, err := NewRecorder(path, ModeRecord)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	rec.Transport = srv.Client().Transport
+
+	client := goshopify.NewClient(goshopify.App{}, "fooshop", "secrettoken",
+		goshopify.WithVersion("2023-01"), goshopify.WithHTTPClient(rec.Client()))
+	created, err := client.Product.Create(goshopify.Product{Title: "Snowboard", Tags: "winter"})
+	if err != nil {
+		t.Fatalf("Product.Create returned error: %v", err)
+	}
+	if _, err := client.Product.List(goshopify.ListOptions{Limit: 10, IDs: []int64{created.ID}}); err != nil {
+		t.Fatalf("Product.List returned error: %v", err)
+	}
+	srv.Close()
+
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Recorder.Save returned error: %v", err)
+	}
+	cassette, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(cassette), "secrettoken") {
+		t.Errorf("cassette contains the access token")
+	}
+
+	replayer, err := NewRecorder(path, ModeReplay)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client = goshopify.NewClient(goshopify.App{}, "fooshop", "othertoken",
+		goshopify.WithVersion("2023-01"), goshopify.WithHTTPClient(replayer.Client()))
+
+	replayed, err := client.Product.Create(goshopify.Product{Title: "Snowboard", Tags: "winter"})
+	if err != nil {
+		t.Fatalf("replayed Product.Create returned error: %v", err)
+	}
+	if replayed.ID != created.ID {
+		t.Errorf("replayed Product.Create returned id %d, expected %d", replayed.ID, created.ID)
+	}
+
+	products, err := client.Product.List(goshopify.ListOptions{IDs: []int64{created.ID}, Limit: 10})
+	if err != nil || len(products) != 1 {
+		t.Errorf("replayed Product.List returned %+v, %v", products, err)
+	}
+
+	if _, err := client.Product.Count(nil); err == nil {
+		t.Errorf("Product.Count without a recorded interaction returned no error")
+	}
+}
+
+func TestScrubBody(t *testing.T) {
+	actual := scrubBody([]byte(`{"client_secret":"hush","client_id":"key","nested":[{"access_token":"abc"}]}`))
+	expected := `{"client_id":"key","client_secret":"[FILTERED]","nested":[{"access_token":"[FILTERED]"}]}`
+	if actual != expected {
+		t.Errorf("scrubBody returned %s, expected %s", actual, expected)
+	}
+}
+
+func TestMatchesReorderedBody(t *testing.T) {
+	recorded := RecordedRequest{
+		Method: "POST",
+		Path:   "/admin/api/2023-01/products.json",
+		Body:   scrubBody([]byte(`{"product":{"title":"Snowboard","tags":"winter","variants":[{"sku":"a","price":"1.00"}]}}`)),
+	}
+	request := RecordedRequest{
+		Method: "POST",
+		Path:   "/admin/api/2023-01/products.json",
+		Body:   scrubBody([]byte(`{"product":{"variants":[{"price":"1.00","sku":"a"}],"tags":"winter","title":"Snowboard"}}`)),
+	}
+	if !matches(recorded, request) {
+		t.Errorf("matches returned false for bodies %s and %s", recorded.Body, request.Body)
+	}
+
+	request.Body = scrubBody([]byte(`{"product":{"tags":"summer","title":"Snowboard","variants":[{"price":"1.00","sku":"a"}]}}`))
+	if matches(recorded, request) {
+		t.Errorf("matches returned true for different bodies %s and %s", recorded.Body, request.Body)
+	}
+}
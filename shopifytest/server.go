@@ -0,0 +1,372 @@
+// Package shopifytest provides an in-memory fake of the Shopify Admin REST API
+// for testing code built on goshopify without hand written responders.
+//
+// The fake stores products, variants, customers, orders and inventory levels,
+// supports create, read, update, delete and count requests, cursor based
+// pagination with Link headers, and simulates the call limit header and 429
+// responses:
+//
+//	srv := shopifytest.NewServer()
+//	defer srv.Close()
+//
+//	client := goshopify.NewClient(app, "fooshop", "token",
+//		goshopify.WithHTTPClient(srv.Client()))
+package shopifytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBucketSize is the call limit bucket size of a new Server
+	DefaultBucketSize = 40
+
+	// DefaultLeakRate is the number of calls per second leaking out of the
+	// call limit bucket of a new Server
+	DefaultLeakRate = 2
+
+	defaultLimit = 50
+	maxLimit     = 250
+)
+
+// apiPathRegex strips the admin prefix and optional api version from a path
+var apiPathRegex = regexp.MustCompile(`^/admin(?:/api/[^/]+)?/(.+)\.json$`)
+
+// Server is an in-memory fake of the Shopify Admin REST API. Create one with
+// NewServer and pass the http client returned by Client to
+// goshopify.WithHTTPClient.
+type Server struct {
+	// URL of the underlying httptest server
+	URL string
+
+	// BucketSize and LeakRate configure the simulated call limit. Requests
+	// made while the bucket is full are rejected with 429 Too Many Requests.
+	BucketSize int
+	LeakRate   float64
+
+	// RetryAfter is the value of the Retry-After header sent with 429
+	// responses, in seconds
+	RetryAfter float64
+
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	nextID      int64
+	orderNumber int
+	bucket      float64
+	leakedAt    time.Time
+	throttled   int
+
+	products  *collection
+	variants  *collection
+	customers *collection
+	orders    *collection
+	levels    map[levelKey]record
+}
+
+type levelKey struct {
+	inventoryItemID int64
+	locationID      int64
+}
+
+// NewServer starts and returns a new empty Server. The caller should call
+// Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		BucketSize: DefaultBucketSize,
+		LeakRate:   DefaultLeakRate,
+		nextID:     1000,
+		leakedAt:   time.Now(),
+		products:   newCollection("product", "products"),
+		variants:   newCollection("variant", "variants"),
+		customers:  newCollection("customer", "customers"),
+		orders:     newCollection("order", "orders"),
+		levels:     map[levelKey]record{},
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns an http client sending every request to the server,
+// whatever the shop domain of the request url.
+func (s *Server) Client() *http.Client {
+	target, _ := url.Parse(s.srv.URL)
+	return &http.Client{
+		Transport: rewriteTransport{target: target, base: s.srv.Client().Transport},
+	}
+}
+
+// Throttle makes the server reject the next n requests with
+// 429 Too Many Requests, regardless of the call limit bucket.
+func (s *Server) Throttle(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.throttled = n
+}
+
+type rewriteTransport struct {
+	target *url.URL
+	base   http.RoundTripper
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.URL.Scheme = t.target.Scheme
+	r.URL.Host = t.target.Host
+	r.Host = req.URL.Host
+	return t.base.RoundTrip(r)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.takeCall(w) {
+		w.Header().Set("Retry-After", strconv.FormatFloat(s.RetryAfter, 'f', 1, 64))
+		writeError(w, http.StatusTooManyRequests, "Exceeded 2 calls per second for api client. Reduce request rates to resume uninterrupted service.")
+		return
+	}
+
+	m := apiPathRegex.FindStringSubmatch(r.URL.Path)
+	if m == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Body != nil && (r.Method == http.MethodPost || r.Method == http.MethodPut) {
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	s.route(w, r, strings.Split(m[1], "/"), body)
+}
+
+// takeCall records a call in the call limit bucket and sets the call limit
+// header, returning false if the call must be rejected.
+func (s *Server) takeCall(w http.ResponseWriter) bool {
+	now := time.Now()
+	s.bucket -= now.Sub(s.leakedAt).Seconds() * s.LeakRate
+	if s.bucket < 0 {
+		s.bucket = 0
+	}
+	s.leakedAt = now
+
+	if s.throttled > 0 || s.bucket+1 > float64(s.BucketSize) {
+		// rejected calls report a full bucket, like Shopify's
+		w.Header().Set("X-Shopify-Shop-Api-Call-Limit", fmt.Sprintf("%d/%d", s.BucketSize, s.BucketSize))
+		if s.throttled > 0 {
+			s.throttled--
+		}
+		return false
+	}
+
+	s.bucket++
+	w.Header().Set("X-Shopify-Shop-Api-Call-Limit", fmt.Sprintf("%d/%d", int(s.bucket+0.5), s.BucketSize))
+	return true
+}
+
+func (s *Server) route(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	if parts[0] == "inventory_levels" {
+		s.routeInventoryLevels(w, r, parts[1:], body)
+		return
+	}
+
+	var c *collection
+	switch parts[0] {
+	case "products":
+		c = s.products
+	case "variants":
+		c = s.variants
+	case "customers":
+		c = s.customers
+	case "orders":
+		c = s.orders
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	switch {
+	case len(parts) == 1:
+		s.routeCollection(w, r, c, nil, body)
+	case len(parts) == 2 && parts[1] == "count":
+		s.count(w, r, c, nil)
+	case len(parts) == 2:
+		s.routeRecord(w, r, c, parts[1], body)
+	case len(parts) >= 3 && c == s.products && parts[2] == "variants":
+		s.routeVariants(w, r, parts[1], parts[3:], body)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) routeCollection(w http.ResponseWriter, r *http.Request, c *collection, scope record, body map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		s.list(w, r, c, scope)
+	case http.MethodPost:
+		data, ok := body[c.singular].(map[string]interface{})
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("%s is required", c.singular))
+			return
+		}
+		for k, v := range scope {
+			data[k] = v
+		}
+		rec := s.create(c, data)
+		writeJSON(w, http.StatusCreated, map[string]interface{}{c.singular: s.render(c, rec)})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+func (s *Server) routeRecord(w http.ResponseWriter, r *http.Request, c *collection, idStr string, body map[string]interface{}) {
+	id, _ := strconv.ParseInt(idStr, 10, 64)
+	rec, ok := c.records[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{c.singular: s.render(c, rec)})
+	case http.MethodPut:
+		data, ok := body[c.singular].(map[string]interface{})
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("%s is required", c.singular))
+			return
+		}
+		s.update(c, rec, data)
+		writeJSON(w, http.StatusOK, map[string]interface{}{c.singular: s.render(c, rec)})
+	case http.MethodDelete:
+		s.delete(c, id)
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+func (s *Server) routeVariants(w http.ResponseWriter, r *http.Request, productIDStr string, parts []string, body map[string]interface{}) {
+	productID, _ := strconv.ParseInt(productIDStr, 10, 64)
+	if _, ok := s.products.records[productID]; !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	scope := record{"product_id": productID}
+
+	switch {
+	case len(parts) == 0:
+		s.routeCollection(w, r, s.variants, scope, body)
+	case len(parts) == 1 && parts[0] == "count":
+		s.count(w, r, s.variants, scope)
+	case len(parts) == 1:
+		id, _ := strconv.ParseInt(parts[0], 10, 64)
+		if v, ok := s.variants.records[id]; !ok || toInt64(v["product_id"]) != productID {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		s.routeRecord(w, r, s.variants, parts[0], body)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, c *collection, scope record) {
+	q := r.URL.Query()
+
+	limit := defaultLimit
+	if l, err := strconv.Atoi(q.Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if limit > maxLimit {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be at most %d", maxLimit))
+		return
+	}
+
+	filterQuery := url.Values{}
+	for k, v := range q {
+		if k != "limit" && k != "page_info" {
+			filterQuery[k] = v
+		}
+	}
+
+	var cursor *pageCursor
+	if pageInfo := q.Get("page_info"); pageInfo != "" {
+		var err error
+		if cursor, err = decodePageInfo(pageInfo); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if filterQuery, err = url.ParseQuery(cursor.Query); err != nil {
+			writeError(w, http.StatusBadRequest, "page_info is invalid")
+			return
+		}
+	}
+
+	matching := c.filter(filterQuery, scope)
+	page, hasPrevious, hasNext := paginate(matching, cursor, limit)
+
+	// records may have been deleted since the cursor was issued, leaving an
+	// empty page without ids to link from
+	links := []string{}
+	if hasPrevious && len(page) > 0 {
+		links = append(links, pageLink(r, limit, "before", toInt64(page[0]["id"]), filterQuery, "previous"))
+	}
+	if hasNext && len(page) > 0 {
+		links = append(links, pageLink(r, limit, "after", toInt64(page[len(page)-1]["id"]), filterQuery, "next"))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	rendered := make([]record, 0, len(page))
+	for _, rec := range page {
+		rendered = append(rendered, s.render(c, rec))
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{c.plural: rendered})
+}
+
+func (s *Server) count(w http.ResponseWriter, r *http.Request, c *collection, scope record) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"count": len(c.filter(r.URL.Query(), scope))})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{"errors": message})
+}
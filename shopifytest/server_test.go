@@ -0,0 +1,337 @@
+package shopifytest
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	goshopify "github.com/bold-commerce/go-shopify/v3"
+)
+
+func newTestClient(srv *Server, opts ...goshopify.Option) *goshopify.Client {
+	opts = append([]goshopify.Option{
+		goshopify.WithVersion("2023-01"),
+		goshopify.WithHTTPClient(srv.Client()),
+	}, opts...)
+	return goshopify.NewClient(goshopify.App{}, "fooshop", "token", opts...)
+}
+
+func TestProductCRUD(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(srv)
+
+	product, err := client.Product.Create(goshopify.Product{
+		Title:    "Burton Custom Freestyle 151",
+		Variants: []goshopify.Variant{{Title: "Small", Option1: "Small"}, {Title: "Large", Option1: "Large"}},
+	})
+	if err != nil {
+		t.Fatalf("Product.Create returned error: %v", err)
+	}
+	if product.ID == 0 || len(product.Variants) != 2 || product.Variants[0].ProductID != product.ID {
+		t.Fatalf("Product.Create returned %+v, expected an id and 2 variants", product)
+	}
+
+	product.Title = "Burton Custom Freestyle 152"
+	product.Variants = nil
+	updated, err := client.Product.Update(*product)
+	if err != nil {
+		t.Fatalf("Product.Update returned error: %v", err)
+	}
+	if updated.Title != "Burton Custom Freestyle 152" || len(updated.Variants) != 2 {
+		t.Errorf("Product.Update returned %+v", updated)
+	}
+
+	got, err := client.Product.Get(product.ID, nil)
+	if err != nil {
+		t.Fatalf("Product.Get returned error: %v", err)
+	}
+	if got.Title != updated.Title {
+		t.Errorf("Product.Get returned title %s, expected %s", got.Title, updated.Title)
+	}
+
+	count, err := client.Variant.Count(product.ID, nil)
+	if err != nil || count != 2 {
+		t.Errorf("Variant.Count returned %d, %v, expected 2", count, err)
+	}
+
+	if err := client.Product.Delete(product.ID); err != nil {
+		t.Fatalf("Product.Delete returned error: %v", err)
+	}
+	if _, err := client.Product.Get(product.ID, nil); err == nil {
+		t.Errorf("Product.Get after delete returned no error")
+	}
+	if _, err := client.Variant.Get(got.Variants[0].ID, nil); err == nil {
+		t.Errorf("Variant.Get after product delete returned no error")
+	}
+}
+
+func TestDefaultVariant(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	product := srv.AddProduct(goshopify.Product{Title: "Gift card"})
+	if len(product.Variants) != 1 || product.Variants[0].Title != "Default Title" {
+		t.Errorf("AddProduct returned variants %+v, expected a default variant", product.Variants)
+	}
+}
+
+func TestCursorPagination(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(srv)
+
+	ids := []int64{}
+	for i := 0; i < 5; i++ {
+		ids = append(ids, srv.AddCustomer(goshopify.Customer{Email: "customer@example.com"}).ID)
+	}
+
+	count, err := client.Customer.Count(nil)
+	if err != nil || count != 5 {
+		t.Fatalf("Customer.Count returned %d, %v, expected 5", count, err)
+	}
+
+	seen := []int64{}
+	options := &goshopify.ListOptions{Limit: 2}
+	for options != nil {
+		customers, pagination, err := client.Customer.ListWithPagination(options)
+		if err != nil {
+			t.Fatalf("Customer.ListWithPagination returned error: %v", err)
+		}
+		for _, c := range customers {
+			seen = append(seen, c.ID)
+		}
+		options = pagination.NextPageOptions
+	}
+
+	if len(seen) != len(ids) {
+		t.Fatalf("Customer.ListWithPagination returned ids %v, expected %v", seen, ids)
+	}
+	for i := range ids {
+		if seen[i] != ids[i] {
+			t.Errorf("Customer.ListWithPagination returned ids %v, expected %v", seen, ids)
+			break
+		}
+	}
+
+	_, pagination, err := client.Customer.ListWithPagination(&goshopify.ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Customer.ListWithPagination returned error: %v", err)
+	}
+	customers, pagination, err := client.Customer.ListWithPagination(pagination.NextPageOptions)
+	if err != nil {
+		t.Fatalf("Customer.ListWithPagination returned error: %v", err)
+	}
+	if pagination.PreviousPageOptions == nil || customers[0].ID != ids[2] {
+		t.Fatalf("Customer.ListWithPagination second page returned %+v, %+v", customers, pagination)
+	}
+	customers, _, err = client.Customer.ListWithPagination(pagination.PreviousPageOptions)
+	if err != nil || len(customers) != 2 || customers[0].ID != ids[0] {
+		t.Errorf("Customer.ListWithPagination previous page returned %+v, %v", customers, err)
+	}
+}
+
+func TestCursorPaginationKeepsFilters(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(srv)
+
+	ids := []int64{}
+	for i := 0; i < 5; i++ {
+		ids = append(ids, srv.AddCustomer(goshopify.Customer{Email: "customer@example.com"}).ID)
+	}
+
+	cases := []struct {
+		name     string
+		options  *goshopify.ListOptions
+		expected []int64
+	}{
+		{"ids", &goshopify.ListOptions{Limit: 1, IDs: []int64{ids[0], ids[1]}}, ids[:2]},
+		{"since_id", &goshopify.ListOptions{Limit: 2, SinceID: ids[2]}, ids[3:]},
+	}
+
+	for _, c := range cases {
+		seen := []int64{}
+		var options interface{} = c.options
+		for options != nil {
+			customers, pagination, err := client.Customer.ListWithPagination(options)
+			if err != nil {
+				t.Fatalf("Customer.ListWithPagination with %s returned error: %v", c.name, err)
+			}
+			for _, customer := range customers {
+				seen = append(seen, customer.ID)
+			}
+			options = nil
+			if pagination.NextPageOptions != nil {
+				options = pagination.NextPageOptions
+			}
+		}
+
+		if !reflect.DeepEqual(seen, c.expected) {
+			t.Errorf("Customer.ListWithPagination with %s returned ids %v, expected %v", c.name, seen, c.expected)
+		}
+	}
+}
+
+func TestCursorPaginationEmptyPage(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(srv)
+
+	products := []goshopify.Product{}
+	for i := 0; i < 3; i++ {
+		products = append(products, srv.AddProduct(goshopify.Product{Title: "Snowboard"}))
+	}
+
+	_, pagination, err := client.Product.ListWithPagination(goshopify.ListOptions{Limit: 2})
+	if err != nil || pagination.NextPageOptions == nil {
+		t.Fatalf("Product.ListWithPagination returned %+v, %v, expected a next page", pagination, err)
+	}
+	if err := client.Product.Delete(products[2].ID); err != nil {
+		t.Fatalf("Product.Delete returned error: %v", err)
+	}
+
+	// the record after the cursor is gone
+	page, pagination, err := client.Product.ListWithPagination(pagination.NextPageOptions)
+	if err != nil {
+		t.Fatalf("Product.ListWithPagination of the next page returned error: %v", err)
+	}
+	if len(page) != 0 || pagination.NextPageOptions != nil || pagination.PreviousPageOptions != nil {
+		t.Errorf("Product.ListWithPagination of the next page returned %v, %+v, expected an empty page", page, pagination)
+	}
+
+	_, pagination, err = client.Product.ListWithPagination(goshopify.ListOptions{Limit: 1})
+	if err != nil || pagination.NextPageOptions == nil {
+		t.Fatalf("Product.ListWithPagination returned %+v, %v, expected a next page", pagination, err)
+	}
+	_, pagination, err = client.Product.ListWithPagination(pagination.NextPageOptions)
+	if err != nil || pagination.PreviousPageOptions == nil {
+		t.Fatalf("Product.ListWithPagination returned %+v, %v, expected a previous page", pagination, err)
+	}
+	if err := client.Product.Delete(products[0].ID); err != nil {
+		t.Fatalf("Product.Delete returned error: %v", err)
+	}
+
+	// the record before the cursor is gone
+	page, pagination, err = client.Product.ListWithPagination(pagination.PreviousPageOptions)
+	if err != nil {
+		t.Fatalf("Product.ListWithPagination of the previous page returned error: %v", err)
+	}
+	if len(page) != 0 || pagination.NextPageOptions != nil || pagination.PreviousPageOptions != nil {
+		t.Errorf("Product.ListWithPagination of the previous page returned %v, %+v, expected an empty page", page, pagination)
+	}
+}
+
+func TestOrderNumbers(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(srv)
+
+	srv.AddOrder(goshopify.Order{Email: "first@example.com"})
+	order, err := client.Order.Create(goshopify.Order{Email: "second@example.com"})
+	if err != nil {
+		t.Fatalf("Order.Create returned error: %v", err)
+	}
+	if order.OrderNumber != 1002 || order.Name != "#1002" {
+		t.Errorf("Order.Create returned order number %d and name %s, expected 1002", order.OrderNumber, order.Name)
+	}
+
+	orders, err := client.Order.List(nil)
+	if err != nil || len(orders) != 2 {
+		t.Errorf("Order.List returned %d orders, %v, expected 2", len(orders), err)
+	}
+}
+
+func TestInventoryLevels(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := newTestClient(srv)
+
+	product := srv.AddProduct(goshopify.Product{Title: "Snowboard"})
+	itemID := product.Variants[0].InventoryItemId
+	srv.SetInventoryLevel(itemID, 1, 10)
+
+	level, err := client.InventoryLevel.Adjust(goshopify.InventoryLevelAdjustOptions{
+		InventoryItemId: itemID,
+		LocationId:      1,
+		Adjust:          -3,
+	})
+	if err != nil {
+		t.Fatalf("InventoryLevel.Adjust returned error: %v", err)
+	}
+	if level.Available != 7 {
+		t.Errorf("InventoryLevel.Adjust returned available %d, expected 7", level.Available)
+	}
+
+	if _, err := client.InventoryLevel.Set(goshopify.InventoryLevel{InventoryItemId: itemID, LocationId: 2, Available: 5}); err != nil {
+		t.Fatalf("InventoryLevel.Set returned error: %v", err)
+	}
+
+	levels, err := client.InventoryLevel.List(goshopify.InventoryLevelListOptions{InventoryItemIds: []int64{itemID}})
+	if err != nil || len(levels) != 2 {
+		t.Fatalf("InventoryLevel.List returned %+v, %v, expected 2 levels", levels, err)
+	}
+
+	variant, err := client.Variant.Get(product.Variants[0].ID, nil)
+	if err != nil {
+		t.Fatalf("Variant.Get returned error: %v", err)
+	}
+	if variant.InventoryQuantity != 12 {
+		t.Errorf("Variant.Get returned inventory quantity %d, expected 12", variant.InventoryQuantity)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	client := newTestClient(srv)
+	if _, err := client.Product.Count(nil); err != nil {
+		t.Fatalf("Product.Count returned error: %v", err)
+	}
+	if client.RateLimits.RequestCount != 1 || client.RateLimits.BucketSize != DefaultBucketSize {
+		t.Errorf("client.RateLimits = %+v, expected 1/%d", client.RateLimits, DefaultBucketSize)
+	}
+
+	srv.Throttle(1)
+	_, err := client.Product.Count(nil)
+	if _, ok := err.(goshopify.RateLimitError); !ok {
+		t.Errorf("Product.Count returned error %v, expected a RateLimitError", err)
+	}
+
+	srv.Throttle(1)
+	resp, err := srv.Client().Get("https://fooshop.myshopify.com/admin/api/2023-01/products/count.json")
+	if err != nil {
+		t.Fatalf("GET products/count.json returned error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("GET products/count.json returned status %d, expected %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if limit := resp.Header.Get("X-Shopify-Shop-Api-Call-Limit"); limit != "40/40" {
+		t.Errorf("429 response X-Shopify-Shop-Api-Call-Limit = %q, expected 40/40", limit)
+	}
+
+	srv.Throttle(2)
+	retryClient := newTestClient(srv, goshopify.WithRetry(3))
+	if _, err := retryClient.Product.Count(nil); err != nil {
+		t.Errorf("Product.Count with retries returned error: %v", err)
+	}
+}
+
+func TestBucketFull(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	srv.BucketSize = 2
+	srv.LeakRate = 0
+
+	client := newTestClient(srv)
+	for i := 0; i < 2; i++ {
+		if _, err := client.Product.Count(nil); err != nil {
+			t.Fatalf("Product.Count returned error: %v", err)
+		}
+	}
+	if _, err := client.Product.Count(nil); err == nil {
+		t.Errorf("Product.Count with a full bucket returned no error")
+	}
+}
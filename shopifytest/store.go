@@ -0,0 +1,423 @@
+package shopifytest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	goshopify "github.com/bold-commerce/go-shopify/v3"
+)
+
+// record is a resource stored as its decoded JSON object
+type record map[string]interface{}
+
+type collection struct {
+	singular string
+	plural   string
+	records  map[int64]record
+}
+
+func newCollection(singular, plural string) *collection {
+	return &collection{singular: singular, plural: plural, records: map[int64]record{}}
+}
+
+// filter returns the records in scope matching the ids and since_id query
+// parameters, ordered by id.
+func (c *collection) filter(q url.Values, scope record) []record {
+	ids := map[int64]bool{}
+	if q.Get("ids") != "" {
+		for _, id := range strings.Split(q.Get("ids"), ",") {
+			i, _ := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+			ids[i] = true
+		}
+	}
+	sinceID, _ := strconv.ParseInt(q.Get("since_id"), 10, 64)
+
+	result := []record{}
+	for id, rec := range c.records {
+		if len(ids) > 0 && !ids[id] {
+			continue
+		}
+		if id <= sinceID {
+			continue
+		}
+		inScope := true
+		for k, v := range scope {
+			if toInt64(rec[k]) != toInt64(v) {
+				inScope = false
+			}
+		}
+		if inScope {
+			result = append(result, rec)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return toInt64(result[i]["id"]) < toInt64(result[j]["id"])
+	})
+	return result
+}
+
+// pageCursor is the content of a page_info cursor. Like Shopify's, it carries
+// the filters of the first request, since they cannot be sent with page_info.
+type pageCursor struct {
+	Direction string `json:"direction"`
+	ID        int64  `json:"last_id"`
+	Query     string `json:"query"`
+}
+
+// decodePageInfo decodes a page_info cursor
+func decodePageInfo(pageInfo string) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(pageInfo)
+	if err != nil {
+		return nil, errors.New("page_info is invalid")
+	}
+	cursor := new(pageCursor)
+	if err := json.Unmarshal(b, cursor); err != nil {
+		return nil, errors.New("page_info is invalid")
+	}
+	if cursor.Direction != "after" && cursor.Direction != "before" {
+		return nil, errors.New("page_info is invalid")
+	}
+	return cursor, nil
+}
+
+// paginate returns the page of records selected by the cursor, the first page
+// if it is nil, and whether there are records before and after it.
+func paginate(records []record, cursor *pageCursor, limit int) ([]record, bool, bool) {
+	start, end := 0, len(records)
+
+	if cursor != nil {
+		switch cursor.Direction {
+		case "after":
+			start = sort.Search(len(records), func(i int) bool { return toInt64(records[i]["id"]) > cursor.ID })
+		case "before":
+			end = sort.Search(len(records), func(i int) bool { return toInt64(records[i]["id"]) >= cursor.ID })
+			start = end - limit
+			if start < 0 {
+				start = 0
+			}
+		}
+	}
+
+	if end-start > limit {
+		end = start + limit
+	}
+	return records[start:end], start > 0, end < len(records)
+}
+
+// pageLink formats a Link header entry for the page before or after id, with
+// the filters of filterQuery
+func pageLink(r *http.Request, limit int, direction string, id int64, filterQuery url.Values, rel string) string {
+	cursor, _ := json.Marshal(pageCursor{Direction: direction, ID: id, Query: filterQuery.Encode()})
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("page_info", base64.RawURLEncoding.EncodeToString(cursor))
+	u := url.URL{Scheme: "https", Host: r.Host, Path: r.URL.Path, RawQuery: q.Encode()}
+	return fmt.Sprintf(`<%s>; rel="%s"`, u.String(), rel)
+}
+
+func (s *Server) newID() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func (s *Server) create(c *collection, data map[string]interface{}) record {
+	rec := record{}
+	for k, v := range data {
+		rec[k] = v
+	}
+	delete(rec, "variants")
+
+	id := s.newID()
+	rec["id"] = id
+	rec["admin_graphql_api_id"] = fmt.Sprintf("gid://shopify/%s/%d", strings.ToUpper(c.singular[:1])+c.singular[1:], id)
+	rec["created_at"] = now()
+	rec["updated_at"] = rec["created_at"]
+	c.records[id] = rec
+
+	switch c {
+	case s.products:
+		variants, _ := data["variants"].([]interface{})
+		if len(variants) == 0 {
+			variants = []interface{}{map[string]interface{}{
+				"title":   "Default Title",
+				"option1": "Default Title",
+				"price":   "0.00",
+			}}
+		}
+		s.upsertVariants(id, variants)
+	case s.variants:
+		if _, ok := rec["inventory_item_id"]; !ok {
+			rec["inventory_item_id"] = s.newID()
+		}
+	case s.orders:
+		s.orderNumber++
+		rec["number"] = s.orderNumber
+		rec["order_number"] = s.orderNumber + 1000
+		rec["name"] = fmt.Sprintf("#%d", s.orderNumber+1000)
+	}
+
+	return rec
+}
+
+func (s *Server) update(c *collection, rec record, data map[string]interface{}) {
+	for k, v := range data {
+		if k == "id" || k == "variants" || k == "created_at" {
+			continue
+		}
+		rec[k] = v
+	}
+	rec["updated_at"] = now()
+
+	if variants, ok := data["variants"].([]interface{}); ok && c == s.products {
+		s.upsertVariants(toInt64(rec["id"]), variants)
+	}
+}
+
+func (s *Server) upsertVariants(productID int64, variants []interface{}) {
+	for _, v := range variants {
+		data, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if existing, ok := s.variants.records[toInt64(data["id"])]; ok && toInt64(existing["product_id"]) == productID {
+			s.update(s.variants, existing, data)
+			continue
+		}
+		data["product_id"] = productID
+		s.create(s.variants, data)
+	}
+}
+
+func (s *Server) delete(c *collection, id int64) {
+	delete(c.records, id)
+	if c == s.products {
+		for variantID, v := range s.variants.records {
+			if toInt64(v["product_id"]) == id {
+				delete(s.variants.records, variantID)
+			}
+		}
+	}
+}
+
+// render returns a copy of the record as sent in responses, with related
+// resources filled in
+func (s *Server) render(c *collection, rec record) record {
+	out := record{}
+	for k, v := range rec {
+		out[k] = v
+	}
+
+	switch c {
+	case s.products:
+		variants := []record{}
+		for _, v := range s.variants.filter(url.Values{}, record{"product_id": rec["id"]}) {
+			variants = append(variants, s.render(s.variants, v))
+		}
+		out["variants"] = variants
+	case s.variants:
+		itemID := toInt64(rec["inventory_item_id"])
+		quantity, found := 0, false
+		for key, level := range s.levels {
+			if key.inventoryItemID == itemID {
+				quantity += int(toInt64(level["available"]))
+				found = true
+			}
+		}
+		if found {
+			out["inventory_quantity"] = quantity
+		}
+	}
+
+	return out
+}
+
+func (s *Server) routeInventoryLevels(w http.ResponseWriter, r *http.Request, parts []string, body map[string]interface{}) {
+	q := r.URL.Query()
+
+	if len(parts) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			itemIDs := parseIDs(q.Get("inventory_item_ids"))
+			locationIDs := parseIDs(q.Get("location_ids"))
+			if len(itemIDs) == 0 && len(locationIDs) == 0 {
+				writeError(w, http.StatusUnprocessableEntity, "inventory_item_ids or location_ids is required")
+				return
+			}
+			levels := []record{}
+			for key, level := range s.levels {
+				if (len(itemIDs) == 0 || itemIDs[key.inventoryItemID]) && (len(locationIDs) == 0 || locationIDs[key.locationID]) {
+					levels = append(levels, level)
+				}
+			}
+			sort.Slice(levels, func(i, j int) bool {
+				a, b := levels[i], levels[j]
+				if toInt64(a["inventory_item_id"]) != toInt64(b["inventory_item_id"]) {
+					return toInt64(a["inventory_item_id"]) < toInt64(b["inventory_item_id"])
+				}
+				return toInt64(a["location_id"]) < toInt64(b["location_id"])
+			})
+			writeJSON(w, http.StatusOK, map[string]interface{}{"inventory_levels": levels})
+		case http.MethodDelete:
+			itemID, _ := strconv.ParseInt(q.Get("inventory_item_id"), 10, 64)
+			locationID, _ := strconv.ParseInt(q.Get("location_id"), 10, 64)
+			key := levelKey{itemID, locationID}
+			if _, ok := s.levels[key]; !ok {
+				writeError(w, http.StatusNotFound, "Not Found")
+				return
+			}
+			delete(s.levels, key)
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		}
+		return
+	}
+
+	if len(parts) != 1 || r.Method != http.MethodPost {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	key := levelKey{toInt64(body["inventory_item_id"]), toInt64(body["location_id"])}
+	if key.inventoryItemID == 0 || key.locationID == 0 {
+		writeError(w, http.StatusUnprocessableEntity, "inventory_item_id and location_id are required")
+		return
+	}
+
+	level, exists := s.levels[key]
+	switch parts[0] {
+	case "connect":
+		if !exists {
+			level = s.setLevel(key, 0)
+		}
+	case "set":
+		level = s.setLevel(key, toInt64(body["available"]))
+	case "adjust":
+		if !exists {
+			writeError(w, http.StatusUnprocessableEntity, "Inventory item is not stocked at the location")
+			return
+		}
+		level = s.setLevel(key, toInt64(level["available"])+toInt64(body["available_adjustment"]))
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"inventory_level": level})
+}
+
+func (s *Server) setLevel(key levelKey, available int64) record {
+	level := record{
+		"inventory_item_id":    key.inventoryItemID,
+		"location_id":          key.locationID,
+		"available":            available,
+		"updated_at":           now(),
+		"admin_graphql_api_id": fmt.Sprintf("gid://shopify/InventoryLevel/%d?inventory_item_id=%d", key.locationID, key.inventoryItemID),
+	}
+	s.levels[key] = level
+	return level
+}
+
+func parseIDs(s string) map[int64]bool {
+	ids := map[int64]bool{}
+	for _, id := range strings.Split(s, ",") {
+		if i, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64); err == nil {
+			ids[i] = true
+		}
+	}
+	return ids
+}
+
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	case float64:
+		return int64(n)
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			f, _ := n.Float64()
+			return int64(f)
+		}
+		return i
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	}
+	return 0
+}
+
+// seed stores a goshopify resource in c and decodes the stored record into
+// result
+func (s *Server) seed(c *collection, resource, result interface{}) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		panic(err)
+	}
+	data := map[string]interface{}{}
+	decoder := json.NewDecoder(strings.NewReader(string(b)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		panic(err)
+	}
+
+	s.mu.Lock()
+	rendered := s.render(c, s.create(c, data))
+	s.mu.Unlock()
+
+	b, err = json.Marshal(rendered)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(b, result); err != nil {
+		panic(err)
+	}
+}
+
+// AddProduct stores a product and its variants and returns it as stored,
+// with ids and timestamps set.
+func (s *Server) AddProduct(product goshopify.Product) goshopify.Product {
+	var result goshopify.Product
+	s.seed(s.products, product, &result)
+	return result
+}
+
+// AddCustomer stores a customer and returns it as stored, with id and
+// timestamps set.
+func (s *Server) AddCustomer(customer goshopify.Customer) goshopify.Customer {
+	var result goshopify.Customer
+	s.seed(s.customers, customer, &result)
+	return result
+}
+
+// AddOrder stores an order and returns it as stored, with id, number and
+// timestamps set.
+func (s *Server) AddOrder(order goshopify.Order) goshopify.Order {
+	var result goshopify.Order
+	s.seed(s.orders, order, &result)
+	return result
+}
+
+// SetInventoryLevel sets the available quantity of an inventory item at a
+// location.
+func (s *Server) SetInventoryLevel(inventoryItemID, locationID int64, available int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setLevel(levelKey{inventoryItemID, locationID}, int64(available))
+}
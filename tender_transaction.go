@@ -0,0 +1,79 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const tenderTransactionsBasePath = "tender_transactions"
+
+// TenderTransactionService is an interface for interfacing with the tender
+// transaction endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/tendertransaction
+type TenderTransactionService interface {
+	List(interface{}) ([]TenderTransaction, error)
+	ListWithPagination(interface{}) ([]TenderTransaction, *Pagination, error)
+}
+
+// TenderTransactionServiceOp handles communication with the tender
+// transaction related methods of the Shopify API.
+type TenderTransactionServiceOp struct {
+	client *Client
+}
+
+// TenderTransaction represents a payment tender processed for an order. The
+// amount is negative for refunds.
+type TenderTransaction struct {
+	ID              int64            `json:"id,omitempty"`
+	OrderID         int64            `json:"order_id,omitempty"`
+	Amount          *decimal.Decimal `json:"amount,omitempty"`
+	Currency        string           `json:"currency,omitempty"`
+	UserID          *int64           `json:"user_id,omitempty"`
+	Test            bool             `json:"test,omitempty"`
+	ProcessedAt     *time.Time       `json:"processed_at,omitempty"`
+	RemoteReference string           `json:"remote_reference,omitempty"`
+	PaymentMethod   string           `json:"payment_method,omitempty"`
+	PaymentDetails  *PaymentDetails  `json:"payment_details,omitempty"`
+}
+
+// TenderTransactionsResource represents the result from the tender_transactions.json endpoint
+type TenderTransactionsResource struct {
+	TenderTransactions []TenderTransaction `json:"tender_transactions"`
+}
+
+// A struct for all available tender transaction list options. Order is
+// either "processed_at ASC" or "processed_at DESC".
+// See: https://shopify.dev/docs/api/admin-rest/2023-10/resources/tendertransaction#get-tender-transactions
+type TenderTransactionListOptions struct {
+	PageInfo       string    `url:"page_info,omitempty"`
+	Limit          int       `url:"limit,omitempty"`
+	SinceID        int64     `url:"since_id,omitempty"`
+	ProcessedAt    time.Time `url:"processed_at,omitempty"`
+	ProcessedAtMin time.Time `url:"processed_at_min,omitempty"`
+	ProcessedAtMax time.Time `url:"processed_at_max,omitempty"`
+	Order          string    `url:"order,omitempty"`
+}
+
+// List tender transactions
+func (s *TenderTransactionServiceOp) List(options interface{}) ([]TenderTransaction, error) {
+	transactions, _, err := s.ListWithPagination(options)
+	if err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+// ListWithPagination lists tender transactions and return pagination to retrieve next/previous results.
+func (s *TenderTransactionServiceOp) ListWithPagination(options interface{}) ([]TenderTransaction, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", tenderTransactionsBasePath)
+	resource := new(TenderTransactionsResource)
+
+	pagination, err := s.client.ListWithPagination(path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.TenderTransactions, pagination, nil
+}
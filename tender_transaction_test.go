@@ -0,0 +1,120 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func tenderTransactionTests(t *testing.T, transaction TenderTransaction) {
+	expectedID := int64(1011222751)
+	if transaction.ID != expectedID {
+		t.Errorf("TenderTransaction.ID returned %+v, expected %+v", transaction.ID, expectedID)
+	}
+
+	expectedOrderID := int64(450789469)
+	if transaction.OrderID != expectedOrderID {
+		t.Errorf("TenderTransaction.OrderID returned %+v, expected %+v", transaction.OrderID, expectedOrderID)
+	}
+
+	expectedAmount := decimal.NewFromFloat(250.94)
+	if transaction.Amount == nil || !transaction.Amount.Equal(expectedAmount) {
+		t.Errorf("TenderTransaction.Amount returned %+v, expected %+v", transaction.Amount, expectedAmount)
+	}
+
+	expectedPaymentMethod := "credit_card"
+	if transaction.PaymentMethod != expectedPaymentMethod {
+		t.Errorf("TenderTransaction.PaymentMethod returned %+v, expected %+v", transaction.PaymentMethod, expectedPaymentMethod)
+	}
+
+	expectedProcessedAt := time.Date(2005, time.August, 5, 16, 59, 12, 0, time.UTC)
+	if transaction.ProcessedAt == nil || !expectedProcessedAt.Equal(*transaction.ProcessedAt) {
+		t.Errorf("TenderTransaction.ProcessedAt returned %+v, expected %+v", transaction.ProcessedAt, expectedProcessedAt)
+	}
+
+	expectedCompany := "Visa"
+	if transaction.PaymentDetails == nil || transaction.PaymentDetails.CreditCardCompany != expectedCompany {
+		t.Errorf("TenderTransaction.PaymentDetails returned %+v, expected company %s", transaction.PaymentDetails, expectedCompany)
+	}
+}
+
+func TestTenderTransactionList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("tender_transactions.json")))
+
+	params := map[string]string{
+		"processed_at_min": "2005-08-01T00:00:00Z",
+		"processed_at_max": "2005-08-31T00:00:00Z",
+		"order":            "processed_at ASC",
+	}
+	httpmock.RegisterResponderWithQuery("GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"tender_transactions": [{"id":1011222751}]}`))
+
+	transactions, err := client.TenderTransaction.List(nil)
+	if err != nil {
+		t.Errorf("TenderTransaction.List returned error: %v", err)
+	}
+	if len(transactions) != 2 {
+		t.Fatalf("TenderTransaction.List returned %d transactions, expected 2", len(transactions))
+	}
+	tenderTransactionTests(t, transactions[1])
+
+	expectedRefund := decimal.NewFromFloat(-250.94)
+	if transactions[0].Amount == nil || !transactions[0].Amount.Equal(expectedRefund) {
+		t.Errorf("TenderTransaction.List returned amount %+v, expected %+v", transactions[0].Amount, expectedRefund)
+	}
+
+	transactions, err = client.TenderTransaction.List(TenderTransactionListOptions{
+		ProcessedAtMin: time.Date(2005, time.August, 1, 0, 0, 0, 0, time.UTC),
+		ProcessedAtMax: time.Date(2005, time.August, 31, 0, 0, 0, 0, time.UTC),
+		Order:          "processed_at ASC",
+	})
+	if err != nil {
+		t.Errorf("TenderTransaction.List returned error: %v", err)
+	}
+
+	expected := []TenderTransaction{{ID: 1011222751}}
+	if !reflect.DeepEqual(transactions, expected) {
+		t.Errorf("TenderTransaction.List returned %+v, expected %+v", transactions, expected)
+	}
+}
+
+func TestTenderTransactionListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, httpmock.ResponderFromResponse(&http.Response{
+		StatusCode: 200,
+		Body:       httpmock.NewRespBodyFromString(`{"tender_transactions": [{"id":1},{"id":2}]}`),
+		Header: http.Header{
+			"Link": {`<http://valid.url?page_info=pageInfoCode&limit=2>; rel="next"`},
+		},
+	}))
+
+	transactions, pagination, err := client.TenderTransaction.ListWithPagination(TenderTransactionListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("TenderTransaction.ListWithPagination returned error: %v", err)
+	}
+
+	expected := []TenderTransaction{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(transactions, expected) {
+		t.Errorf("TenderTransaction.ListWithPagination returned %+v, expected %+v", transactions, expected)
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pageInfoCode", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("TenderTransaction.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
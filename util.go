@@ -3,10 +3,14 @@ package goshopify
 import (
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 	"time"
 )
 
+// shopNameRegex matches the subdomain part of a myshopify domain
+var shopNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+
 // Return the full shop name, including .myshopify.com
 func ShopFullName(name string) string {
 	name = strings.TrimSpace(name)
@@ -31,6 +35,25 @@ func ShopBaseUrl(name string) string {
 	return fmt.Sprintf("https://%s", name)
 }
 
+// ValidateShopDomain returns an error unless shop is a valid myshopify domain,
+// e.g. "theshop.myshopify.com". Use it to check untrusted shop parameters,
+// such as those sent to OAuth redirects, before building URLs with them.
+// Additional domains, e.g. "myshopify.io" for custom test hosts, can be
+// allowed with allowedDomains.
+func ValidateShopDomain(shop string, allowedDomains ...string) error {
+	domains := append([]string{"myshopify.com"}, allowedDomains...)
+	for _, domain := range domains {
+		suffix := "." + strings.ToLower(domain)
+		if !strings.HasSuffix(strings.ToLower(shop), suffix) {
+			continue
+		}
+		if shopNameRegex.MatchString(shop[:len(shop)-len(suffix)]) {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid shop domain %q", shop)
+}
+
 // Return the prefix for a metafield path
 func MetafieldPathPrefix(resource string, resourceID int64) string {
 	prefix := "metafields"
@@ -40,6 +63,15 @@ func MetafieldPathPrefix(resource string, resourceID int64) string {
 	return prefix
 }
 
+// Return the prefix for an event path
+func EventPathPrefix(resource string, resourceID int64) string {
+	prefix := eventsBasePath
+	if resource != "" {
+		prefix = fmt.Sprintf("%s/%d/%s", resource, resourceID, eventsBasePath)
+	}
+	return prefix
+}
+
 // Return the prefix for a fulfillment path
 func FulfillmentPathPrefix(resource string, resourceID int64) string {
 	prefix := "fulfillments"
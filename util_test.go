@@ -26,6 +26,39 @@ func TestShopFullName(t *testing.T) {
 	}
 }
 
+func TestValidateShopDomain(t *testing.T) {
+	cases := []struct {
+		in             string
+		allowedDomains []string
+		valid          bool
+	}{
+		{"myshop.myshopify.com", nil, true},
+		{"my-shop-2.myshopify.com", nil, true},
+		{"MyShop.MyShopify.com", nil, true},
+		{"myshop", nil, false},
+		{"myshop.myshopify.com.evil.com", nil, false},
+		{"evil.com/myshop.myshopify.com", nil, false},
+		{"evil.com?myshopify.com", nil, false},
+		{"evil.com#.myshopify.com", nil, false},
+		{"myshop.evil.myshopify.com", nil, false},
+		{"-myshop.myshopify.com", nil, false},
+		{"myshop-.myshopify.com", nil, false},
+		{"m.myshopify.com", nil, true},
+		{".myshopify.com", nil, false},
+		{"my shop.myshopify.com", nil, false},
+		{"myshop.myshopify.io", nil, false},
+		{"myshop.myshopify.io", []string{"myshopify.io"}, true},
+		{"myshop.myshopify.com", []string{"myshopify.io"}, true},
+	}
+
+	for _, c := range cases {
+		err := ValidateShopDomain(c.in, c.allowedDomains...)
+		if (err == nil) != c.valid {
+			t.Errorf("ValidateShopDomain(%q, %v): expected valid %v, got error %v", c.in, c.allowedDomains, c.valid, err)
+		}
+	}
+}
+
 func TestShopShortName(t *testing.T) {
 	cases := []struct {
 		in, expected string